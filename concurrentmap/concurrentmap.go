@@ -1,9 +1,15 @@
 package concurrentmap
 
 import (
+	"iter"
+	"maps"
 	"sync"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
 )
 
+var _ containers.Map[int, any] = (*CMap[int, any])(nil)
+
 // CMap - concurrent-safety map.
 type CMap[K comparable, V any] struct {
 	mu sync.RWMutex
@@ -68,6 +74,77 @@ func (c *CMap[K, V]) Delete(key K) bool {
 	return false
 }
 
+// Len - returns count of entries.
+func (c *CMap[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.m)
+}
+
+// IsEmpty - returns true, if there are no entries.
+func (c *CMap[K, V]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+// Clear - deletes all entries.
+func (c *CMap[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.m = make(map[K]V)
+}
+
+// All - returns iterator over snapshot of entries,
+// map can be modified during iteration.
+func (c *CMap[K, V]) All() iter.Seq2[K, V] {
+	snapshot := c.snapshot()
+
+	return func(yield func(K, V) bool) {
+		for k, v := range snapshot {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys - returns iterator over snapshot of keys,
+// map can be modified during iteration.
+func (c *CMap[K, V]) Keys() iter.Seq[K] {
+	snapshot := c.snapshot()
+
+	return func(yield func(K) bool) {
+		for k := range snapshot {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values - returns iterator over snapshot of values,
+// map can be modified during iteration.
+func (c *CMap[K, V]) Values() iter.Seq[V] {
+	snapshot := c.snapshot()
+
+	return func(yield func(V) bool) {
+		for _, v := range snapshot {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot - returns copy of underlying map m.
+func (c *CMap[K, V]) snapshot() map[K]V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return maps.Clone(c.m)
+}
+
 // getMap - returns underlying map m
 func (c *CMap[K, V]) getMap() map[K]V {
 	return c.m
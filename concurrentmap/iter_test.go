@@ -0,0 +1,44 @@
+package concurrentmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIterators(t *testing.T) {
+	t.Parallel()
+
+	m := New[int, string]()
+	m.Insert(1, "1")
+	m.Insert(2, "2")
+	m.Insert(3, "3")
+
+	count := 0
+	for k, v := range m.All() {
+		m.Delete(k) // snapshot iteration allows modification
+		if v == "" {
+			t.Fatalf("Expected value by key: %d\nGot: empty", k)
+		}
+		count++
+		if count == 2 {
+			break
+		}
+	}
+
+	if m.Len() != 1 {
+		t.Fatalf("Expected length: 1\nGot: %d", m.Len())
+	}
+
+	keys := slices.Collect(m.Keys())
+	values := slices.Collect(m.Values())
+	if len(keys) != 1 || len(values) != 1 {
+		t.Fatalf("Expected single entry\nGot: %v, %v", keys, values)
+	}
+
+	for range m.Values() {
+		break
+	}
+	for range m.Keys() {
+		break
+	}
+}
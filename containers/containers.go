@@ -0,0 +1,48 @@
+// Package containers - common interfaces of data structures,
+// to write generic code over them.
+package containers
+
+// Container - data structure, that holds elements.
+type Container interface {
+	// Len - returns count of elements.
+	Len() int
+	// IsEmpty - returns true, if there are no elements.
+	IsEmpty() bool
+	// Clear - removes all elements.
+	Clear()
+}
+
+// Collection - Container of T elements.
+type Collection[T any] interface {
+	Container
+	// Add - adds element, returns false if it can't be added.
+	Add(element T) bool
+	// ToSlice - returns all elements in the container's iteration order,
+	// each type documents its own order.
+	ToSlice() []T
+}
+
+// Sequence - ordered Collection with accessible ends.
+type Sequence[T any] interface {
+	Collection[T]
+	// Front - returns first element,
+	// if Sequence is empty, returns zero value and false.
+	Front() (T, bool)
+	// Back - returns last element,
+	// if Sequence is empty, returns zero value and false.
+	Back() (T, bool)
+}
+
+// Map - Container of values by unique keys.
+type Map[K, V any] interface {
+	Container
+	// Insert - creates or replaces entry with provided key, value.
+	Insert(key K, value V)
+	// Get - returns value by key and true,
+	// if entry with key not exist, returns zero value and false.
+	Get(key K) (V, bool)
+	// Update - sets value of existing entry, returns false if it not exist.
+	Update(key K, value V) bool
+	// Delete - deletes entry by key, returns false if it not exist.
+	Delete(key K) bool
+}
@@ -0,0 +1,109 @@
+package containers_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/concurrentmap"
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/doublylinkedlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/indexlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/singlylinkedlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/unrolledlist"
+	"github.com/seriozhakorneev/go-data-structures/queue"
+	"github.com/seriozhakorneev/go-data-structures/stack"
+)
+
+// fill - generic code over any Collection.
+func fill[T any](c containers.Collection[T], values ...T) {
+	c.Clear()
+	for _, v := range values {
+		c.Add(v)
+	}
+}
+
+func TestCollection(t *testing.T) {
+	t.Parallel()
+
+	s, q := stack.New[int](), queue.New[int](0)
+	collections := map[string]containers.Collection[int]{
+		"Stack":     &s,
+		"Queue":     &q,
+		"LockFree":  stack.NewLockFree[int](),
+		"Doubly":    doublylinkedlist.New[int](),
+		"Singly":    singlylinkedlist.New[int](),
+		"SyncStack": stack.NewSync[int](),
+	}
+
+	for name, c := range collections {
+		fill(c, 1, 2, 3)
+
+		if c.Len() != 3 || c.IsEmpty() {
+			t.Fatalf("%s: expected length: 3\nGot: %d", name, c.Len())
+		}
+
+		c.Clear()
+		if !c.IsEmpty() {
+			t.Fatalf("%s: expected empty collection\nGot: %v", name, c.ToSlice())
+		}
+	}
+
+	fill[int](&q, 1, 2, 3)
+	if exp, got := []int{1, 2, 3}, q.ToSlice(); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected queue: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	t.Parallel()
+
+	q := queue.New[int](0)
+	sequences := map[string]containers.Sequence[int]{
+		"Queue":     &q,
+		"Doubly":    doublylinkedlist.New[int](),
+		"Singly":    singlylinkedlist.New[int](),
+		"IndexList": indexlist.New[int](),
+		"Unrolled":  unrolledlist.New[int](0),
+	}
+
+	for name, s := range sequences {
+		fill(s, 1, 2, 3)
+		front, _ := s.Front()
+		back, _ := s.Back()
+		if front != 1 || back != 3 {
+			t.Fatalf("%s: expected front: 1, back: 3\nGot: %d, %d", name, front, back)
+		}
+
+		s.Clear()
+		if v, ok := s.Front(); ok {
+			t.Fatalf("%s: expected no front after Clear\nGot: %d", name, v)
+		}
+		if v, ok := s.Back(); ok {
+			t.Fatalf("%s: expected no back after Clear\nGot: %d", name, v)
+		}
+		if got := s.ToSlice(); len(got) != 0 {
+			t.Fatalf("%s: expected no elements after Clear\nGot: %v", name, got)
+		}
+
+		fill(s, 4, 5)
+		if exp, got := []int{4, 5}, s.ToSlice(); !reflect.DeepEqual(exp, got) {
+			t.Fatalf("%s: expected: %v\nGot: %v", name, exp, got)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	var m containers.Map[string, int] = concurrentmap.New[string, int]()
+	m.Insert("a", 1)
+
+	if v, ok := m.Get("a"); !ok || v != 1 || m.Len() != 1 {
+		t.Fatalf("Expected entry a: 1\nGot: %d, %t", v, ok)
+	}
+
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Fatalf("Expected empty map\nGot length: %d", m.Len())
+	}
+}
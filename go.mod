@@ -1,3 +1,3 @@
 module github.com/seriozhakorneev/go-data-structures
 
-go 1.19
+go 1.23
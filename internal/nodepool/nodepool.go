@@ -0,0 +1,54 @@
+// Package nodepool - slab allocator of linked list nodes,
+// shared by lists of different node types.
+package nodepool
+
+// DefaultSlabSize - count of nodes, allocated by Pool at once by default.
+const DefaultSlabSize = 64
+
+// Pool - allocator of nodes of type N, that takes them from slabs,
+// allocated by one heap allocation, and reuses released ones.
+// Pool is not safe for concurrent use.
+type Pool[N any] struct {
+	// free - released nodes.
+	free []*N
+	// slab - nodes, that were never used yet.
+	slab     []N
+	slabSize int
+}
+
+// New - returns new Pool, that allocates slabs of provided size.
+// If size is not positive, DefaultSlabSize is used.
+func New[N any](slabSize int) *Pool[N] {
+	if slabSize <= 0 {
+		slabSize = DefaultSlabSize
+	}
+	return &Pool[N]{slabSize: slabSize}
+}
+
+// Get - returns unused zero node.
+func (p *Pool[N]) Get() *N {
+	if last := len(p.free) - 1; last >= 0 {
+		n := p.free[last]
+		p.free[last], p.free = nil, p.free[:last]
+		return n
+	}
+
+	if len(p.slab) == 0 {
+		p.slab = make([]N, p.slabSize)
+	}
+	n := &p.slab[0]
+	p.slab = p.slab[1:]
+	return n
+}
+
+// Put - zeroes n, dropping its value and links, and keeps it for reuse.
+func (p *Pool[N]) Put(n *N) {
+	var zero N
+	*n = zero
+	p.free = append(p.free, n)
+}
+
+// Free - returns count of released nodes, kept for reuse.
+func (p *Pool[N]) Free() int {
+	return len(p.free)
+}
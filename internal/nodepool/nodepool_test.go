@@ -0,0 +1,36 @@
+package nodepool
+
+import "testing"
+
+type node struct {
+	value int
+	next  *node
+}
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	p := New[node](2)
+	a, b, c := p.Get(), p.Get(), p.Get()
+	if a == b || b == c || a == c || len(p.slab) != 1 {
+		t.Fatalf("Expected distinct nodes from slabs of 2")
+	}
+
+	b.value, b.next = 7, a
+	p.Put(b)
+	if p.Free() != 1 || b.value != 0 || b.next != nil {
+		t.Fatalf("Expected zeroed free node\nGot: %+v, free %d", *b, p.Free())
+	}
+
+	if got := p.Get(); got != b || p.Free() != 0 {
+		t.Fatalf("Expected released node to be reused\nGot: %p, %p", b, got)
+	}
+	d := &p.slab[0]
+	if got := p.Get(); got != d || len(p.slab) != 0 {
+		t.Fatalf("Expected the last node of slab\nGot: %p, %p", d, got)
+	}
+
+	if New[node](0).slabSize != DefaultSlabSize {
+		t.Fatalf("Expected default slab size: %d", DefaultSlabSize)
+	}
+}
@@ -0,0 +1,369 @@
+// Package ordered - ordered map queries, shared by binary search trees.
+// Map holds the Node's and answers searches, neighbours and iteration,
+// while each tree provides only its own insert, delete and rebalance
+// as a Balancer.
+package ordered
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Node - binary search tree Node, that holds entry and height of its subtree.
+type Node[K, V any] struct {
+	Key         K
+	Value       V
+	Left, Right *Node[K, V]
+	// Height - count of Node's on the longest path down to leaf.
+	Height int
+	// Red - color of link from parent to Node, used by red-black trees only.
+	Red bool
+}
+
+// Height - returns height of n subtree, 0 for nil.
+func Height[K, V any](n *Node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.Height
+}
+
+// Update - recalculates height of n from its children.
+func (n *Node[K, V]) Update() {
+	n.Height = 1 + max(Height(n.Left), Height(n.Right))
+}
+
+// Min - returns Node with the least key in n subtree.
+func (n *Node[K, V]) Min() *Node[K, V] {
+	for n.Left != nil {
+		n = n.Left
+	}
+	return n
+}
+
+// Max - returns Node with the greatest key in n subtree.
+func (n *Node[K, V]) Max() *Node[K, V] {
+	for n.Right != nil {
+		n = n.Right
+	}
+	return n
+}
+
+// entry - returns entry of n and true, or zero values and false for nil.
+func (n *Node[K, V]) entry() (K, V, bool) {
+	if n == nil {
+		var zero Node[K, V]
+		return zero.Key, zero.Value, false
+	}
+	return n.Key, n.Value, true
+}
+
+// Balancer - changes Node's of a tree, keeping its own balance invariants.
+type Balancer[K, V any] interface {
+	// Insert - creates new entry in n subtree or replaces value of existing one,
+	// returns new subtree root and true, if entry is new.
+	Insert(n *Node[K, V], key K, value V, compare func(a, b K) int) (*Node[K, V], bool)
+	// Delete - deletes entry from n subtree,
+	// returns new subtree root and true, if entry was deleted.
+	Delete(n *Node[K, V], key K, compare func(a, b K) int) (*Node[K, V], bool)
+}
+
+// Insert - inserts entry into n subtree without balancing,
+// calls fix for every Node on the path back to root,
+// returns new subtree root and true, if entry is new.
+func Insert[K, V any](
+	n *Node[K, V], key K, value V, compare func(a, b K) int, fix func(*Node[K, V]) *Node[K, V],
+) (*Node[K, V], bool) {
+	if n == nil {
+		return &Node[K, V]{Key: key, Value: value, Height: 1}, true
+	}
+
+	var added bool
+	switch c := compare(key, n.Key); {
+	case c < 0:
+		n.Left, added = Insert(n.Left, key, value, compare, fix)
+	case c > 0:
+		n.Right, added = Insert(n.Right, key, value, compare, fix)
+	default:
+		n.Value = value
+		return n, false
+	}
+	return fix(n), added
+}
+
+// Delete - deletes entry from n subtree, Node with two children
+// is replaced by its successor, calls fix for every Node on the path
+// back to root, returns new subtree root and true, if entry was deleted.
+func Delete[K, V any](
+	n *Node[K, V], key K, compare func(a, b K) int, fix func(*Node[K, V]) *Node[K, V],
+) (*Node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	deleted := true
+	switch c := compare(key, n.Key); {
+	case c < 0:
+		n.Left, deleted = Delete(n.Left, key, compare, fix)
+	case c > 0:
+		n.Right, deleted = Delete(n.Right, key, compare, fix)
+	case n.Left == nil:
+		return n.Right, true
+	case n.Right == nil:
+		return n.Left, true
+	default:
+		successor := n.Right.Min()
+		n.Key, n.Value = successor.Key, successor.Value
+		n.Right, _ = Delete(n.Right, successor.Key, compare, fix)
+	}
+	return fix(n), deleted
+}
+
+// Map - maps ordered keys to values, stored in Node's of binary search tree,
+// that is changed by Balancer. Trees embed Map to get its queries.
+type Map[K, V any] struct {
+	root   *Node[K, V]
+	length int
+
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare  func(a, b K) int
+	balancer Balancer[K, V]
+}
+
+// New - returns new empty Map, ordered by compare and changed by balancer.
+func New[K, V any](compare func(a, b K) int, balancer Balancer[K, V]) Map[K, V] {
+	return Map[K, V]{compare: compare, balancer: balancer}
+}
+
+// Root - returns root Node of m, nil if m is empty.
+func Root[K, V any](m *Map[K, V]) *Node[K, V] {
+	return m.root
+}
+
+// Len - returns count of entries.
+func (m *Map[K, V]) Len() int {
+	return m.length
+}
+
+// Depth - returns count of edges on the longest path from root to leaf,
+// -1 for empty tree.
+func (m *Map[K, V]) Depth() int {
+	return Height(m.root) - 1
+}
+
+// IsEmpty - returns true, if there are no entries.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.length == 0
+}
+
+// Clear - deletes all entries.
+func (m *Map[K, V]) Clear() {
+	m.root, m.length = nil, 0
+}
+
+// Insert - creates new entry with provided key, value,
+// or replaces value of existing one.
+func (m *Map[K, V]) Insert(key K, value V) {
+	var added bool
+	if m.root, added = m.balancer.Insert(m.root, key, value, m.compare); added {
+		m.length++
+	}
+}
+
+// Delete - returns true, if entry by provided key are deleted,
+// else return false.
+func (m *Map[K, V]) Delete(key K) bool {
+	var deleted bool
+	if m.root, deleted = m.balancer.Delete(m.root, key, m.compare); deleted {
+		m.length--
+	}
+	return deleted
+}
+
+// find - returns Node with provided key, or nil.
+func (m *Map[K, V]) find(key K) *Node[K, V] {
+	return Find(m.root, key, m.compare)
+}
+
+// Find - returns Node with provided key from n subtree, or nil.
+func Find[K, V any](n *Node[K, V], key K, compare func(a, b K) int) *Node[K, V] {
+	for n != nil {
+		switch c := compare(key, n.Key); {
+		case c < 0:
+			n = n.Left
+		case c > 0:
+			n = n.Right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Search - returns value lying at provided key and true,
+// if entry with key not exist, returns zero value and false.
+func (m *Map[K, V]) Search(key K) (V, bool) {
+	if n := m.find(key); n != nil {
+		return n.Value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Get - the same as Search.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return m.Search(key)
+}
+
+// Update - returns true, if value for key are set, else returns false.
+func (m *Map[K, V]) Update(key K, value V) bool {
+	if n := m.find(key); n != nil {
+		n.Value = value
+		return true
+	}
+	return false
+}
+
+// Min - returns entry with the least key,
+// if Map is empty, returns zero values and false.
+func (m *Map[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		return m.root.entry()
+	}
+	return m.root.Min().entry()
+}
+
+// Max - returns entry with the greatest key,
+// if Map is empty, returns zero values and false.
+func (m *Map[K, V]) Max() (K, V, bool) {
+	if m.root == nil {
+		return m.root.entry()
+	}
+	return m.root.Max().entry()
+}
+
+// lower - returns Node with the greatest key less than provided,
+// or equal to it if inclusive, or nil.
+func (m *Map[K, V]) lower(key K, inclusive bool) *Node[K, V] {
+	var found *Node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(n.Key, key)
+		if c < 0 || c == 0 && inclusive {
+			found, n = n, n.Right
+		} else {
+			n = n.Left
+		}
+	}
+	return found
+}
+
+// higher - returns Node with the least key greater than provided,
+// or equal to it if inclusive, or nil.
+func (m *Map[K, V]) higher(key K, inclusive bool) *Node[K, V] {
+	var found *Node[K, V]
+	for n := m.root; n != nil; {
+		c := m.compare(n.Key, key)
+		if c > 0 || c == 0 && inclusive {
+			found, n = n, n.Left
+		} else {
+			n = n.Right
+		}
+	}
+	return found
+}
+
+// Floor - returns entry with the greatest key less than or equal
+// to provided key, if there is no such entry, returns false.
+func (m *Map[K, V]) Floor(key K) (K, V, bool) {
+	return m.lower(key, true).entry()
+}
+
+// Ceiling - returns entry with the least key greater than or equal
+// to provided key, if there is no such entry, returns false.
+func (m *Map[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.higher(key, true).entry()
+}
+
+// Predecessor - returns entry with the greatest key less than
+// provided key, if there is no such entry, returns false.
+func (m *Map[K, V]) Predecessor(key K) (K, V, bool) {
+	return m.lower(key, false).entry()
+}
+
+// Successor - returns entry with the least key greater than
+// provided key, if there is no such entry, returns false.
+func (m *Map[K, V]) Successor(key K) (K, V, bool) {
+	return m.higher(key, false).entry()
+}
+
+// All - returns iterator over entries in increasing order of keys.
+// Map must not be modified during iteration.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.ascend(yield)
+	}
+}
+
+// Backward - returns iterator over entries in decreasing order of keys.
+// Map must not be modified during iteration.
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.root.descend(yield)
+	}
+}
+
+// ascend - returns false, if yield stopped the traversal.
+func (n *Node[K, V]) ascend(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.Left.ascend(yield) && yield(n.Key, n.Value) && n.Right.ascend(yield)
+}
+
+// descend - returns false, if yield stopped the traversal.
+func (n *Node[K, V]) descend(yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.Right.descend(yield) && yield(n.Key, n.Value) && n.Left.descend(yield)
+}
+
+// Validate - checks invariants of m, shared by all trees: order of keys,
+// heights of Node's and Len. Returns error describing the first violation,
+// without package prefix, which is added by caller.
+func Validate[K, V any](m *Map[K, V]) error {
+	count := 0
+
+	var rec func(n *Node[K, V], low, high *K) error
+	rec = func(n *Node[K, V], low, high *K) error {
+		if n == nil {
+			return nil
+		}
+		if low != nil && m.compare(n.Key, *low) <= 0 || high != nil && m.compare(n.Key, *high) >= 0 {
+			return fmt.Errorf("key %v out of order", n.Key)
+		}
+		count++
+
+		if err := rec(n.Left, low, &n.Key); err != nil {
+			return err
+		}
+		if err := rec(n.Right, &n.Key, high); err != nil {
+			return err
+		}
+
+		if h := 1 + max(Height(n.Left), Height(n.Right)); n.Height != h {
+			return fmt.Errorf("height of key %v is %d, expected %d", n.Key, n.Height, h)
+		}
+		return nil
+	}
+
+	if err := rec(m.root, nil, nil); err != nil {
+		return err
+	}
+	if count != m.length {
+		return fmt.Errorf("length %d, expected %d", m.length, count)
+	}
+	return nil
+}
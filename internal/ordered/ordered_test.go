@@ -0,0 +1,139 @@
+package ordered
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+)
+
+// plain - inserts and deletes entries without balancing.
+type plain struct{}
+
+// update - recalculates height of n, returns n.
+func update(n *Node[int, string]) *Node[int, string] {
+	n.Update()
+	return n
+}
+
+func (plain) Insert(
+	n *Node[int, string], key int, value string, compare func(a, b int) int,
+) (*Node[int, string], bool) {
+	return Insert(n, key, value, compare, update)
+}
+
+func (plain) Delete(n *Node[int, string], key int, compare func(a, b int) int) (*Node[int, string], bool) {
+	return Delete(n, key, compare, update)
+}
+
+// testMap - returns Map with keys 10, 20, 30, 40.
+func testMap() *Map[int, string] {
+	m := New[int, string](cmp.Compare[int], plain{})
+	for _, k := range []int{20, 10, 40, 30} {
+		m.Insert(k, "")
+	}
+	return &m
+}
+
+func TestQueries(t *testing.T) {
+	t.Parallel()
+
+	empty := New[int, string](cmp.Compare[int], plain{})
+	if _, _, ok := empty.Min(); ok || empty.Depth() != -1 || !empty.IsEmpty() {
+		t.Fatalf("Expected empty Map")
+	}
+	if _, _, ok := empty.Floor(1); ok {
+		t.Fatalf("Expected no Floor in empty Map")
+	}
+
+	m := testMap()
+	tests := []struct {
+		name string
+		find func(int) (int, string, bool)
+		key  int
+		exp  int
+		ok   bool
+	}{
+		{"floor", m.Floor, 25, 20, true},
+		{"floor", m.Floor, 30, 30, true},
+		{"floor", m.Floor, 5, 0, false},
+		{"ceiling", m.Ceiling, 25, 30, true},
+		{"ceiling", m.Ceiling, 45, 0, false},
+		{"predecessor", m.Predecessor, 20, 10, true},
+		{"predecessor", m.Predecessor, 10, 0, false},
+		{"successor", m.Successor, 20, 30, true},
+		{"successor", m.Successor, 40, 0, false},
+	}
+	for _, tt := range tests {
+		if k, _, ok := tt.find(tt.key); k != tt.exp || ok != tt.ok {
+			t.Fatalf("Expected %s of %d: %d, %v\nGot: %d, %v", tt.name, tt.key, tt.exp, tt.ok, k, ok)
+		}
+	}
+
+	if !m.Update(30, "thirty") || m.Update(35, "") {
+		t.Fatalf("Expected update of existing key only")
+	}
+	if v, ok := m.Get(30); !ok || v != "thirty" {
+		t.Fatalf("Expected: thirty, true\nGot: %s, %v", v, ok)
+	}
+
+	var keys []int
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	if exp := []int{40, 30, 20, 10}; !reflect.DeepEqual(keys, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, keys)
+	}
+}
+
+func TestInsertDelete(t *testing.T) {
+	t.Parallel()
+
+	m := testMap()
+	m.Insert(30, "again")
+	if m.Len() != 4 || m.Depth() != 2 {
+		t.Fatalf("Expected length: 4, depth: 2\nGot: %d, %d", m.Len(), m.Depth())
+	}
+
+	// root with two children is replaced by its successor
+	if !m.Delete(20) || Root(m).Key != 30 || m.Delete(20) {
+		t.Fatalf("Expected root to be replaced by successor: 30\nGot: %d", Root(m).Key)
+	}
+	if err := Validate(m); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []int
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	if exp := []int{10, 30, 40}; !reflect.DeepEqual(keys, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, keys)
+	}
+
+	m.Clear()
+	if !m.IsEmpty() || Root(m) != nil {
+		t.Fatalf("Expected empty Map after Clear")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	m := testMap()
+	Root(m).Left.Key = 25
+	if err := Validate(m); err == nil {
+		t.Fatalf("Expected error for keys out of order")
+	}
+
+	m = testMap()
+	Root(m).Height = 1
+	if err := Validate(m); err == nil {
+		t.Fatalf("Expected error for wrong height")
+	}
+
+	m = testMap()
+	Root(m).Left = nil
+	if err := Validate(m); err == nil {
+		t.Fatalf("Expected error for wrong length")
+	}
+}
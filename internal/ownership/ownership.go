@@ -0,0 +1,59 @@
+// Package ownership - ownership tokens shared by linked lists,
+// to check in O(1), whether a node belongs to a list,
+// and to hand all nodes of one list over to another in O(1).
+package ownership
+
+// Token - identifies the list L, nodes belong to.
+// When nodes of one list are moved to another,
+// Token of the first one is forwarded to Token of the second.
+type Token[L any] struct {
+	list *L
+	next *Token[L]
+}
+
+// Resolve - returns list, that owns the Token, following forwards,
+// or nil if Token is nil or its nodes were released.
+func (t *Token[L]) Resolve() *L {
+	if t == nil {
+		return nil
+	}
+
+	for t.next != nil {
+		if t.next.next != nil {
+			t.next = t.next.next
+		}
+		t = t.next
+	}
+	return t.list
+}
+
+// Owner - Token of a list, created lazily.
+// Zero value is ready to use.
+type Owner[L any] struct {
+	token *Token[L]
+}
+
+// Token - returns Token of list l, creates it if needed.
+func (o *Owner[L]) Token(l *L) *Token[L] {
+	if o.token == nil {
+		o.token = &Token[L]{list: l}
+	}
+	return o.token
+}
+
+// Forward - hands all nodes over to the list, that owns to, in O(1).
+// Owner gets new Token on next use.
+func (o *Owner[L]) Forward(to *Token[L]) {
+	if o.token != nil {
+		o.token.list, o.token.next = nil, to
+		o.token = nil
+	}
+}
+
+// Release - disowns all nodes in O(1).
+// Owner gets new Token on next use.
+func (o *Owner[L]) Release() {
+	if o.token != nil {
+		o.token.list, o.token = nil, nil
+	}
+}
@@ -0,0 +1,198 @@
+// Package seqfmt - formatting helpers shared by sequential containers.
+package seqfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Limit - count of elements printed with %v and %s verbs,
+// before the rest of elements are truncated.
+// Use %+v to print all elements, or precision (%.5v) to set own limit.
+const Limit = 16
+
+// Printer - writes elements of container to fmt.State
+// in form of "[e1 e2 e3 ... +N more]".
+type Printer struct {
+	f      fmt.State
+	format string
+	limit  int
+	count  int
+}
+
+// New - returns Printer for provided fmt.State and verb.
+// For %v and %s verbs, '+' flag disables truncation and precision
+// sets the count of printed elements, other flags are passed to elements.
+// Any other verb is applied to every element with all provided flags.
+func New(f fmt.State, verb rune) *Printer {
+	p := &Printer{f: f, limit: -1}
+
+	switch verb {
+	case 'v', 's':
+		p.limit = Limit
+		if prec, ok := f.Precision(); ok {
+			p.limit = prec
+		}
+		if f.Flag('+') {
+			p.limit = -1
+		}
+		p.format = buildFormat(f, verb, false)
+	default:
+		p.format = buildFormat(f, verb, true)
+	}
+
+	_, _ = f.Write([]byte{'['})
+	return p
+}
+
+// Add - writes element v, returns false if limit of printed elements
+// is reached, so caller can stop adding elements.
+func (p *Printer) Add(v any) bool {
+	if p.limit >= 0 && p.count >= p.limit {
+		return false
+	}
+
+	if p.count > 0 {
+		_, _ = p.f.Write([]byte{' '})
+	}
+	_, _ = fmt.Fprintf(p.f, p.format, v)
+	p.count++
+
+	return p.limit < 0 || p.count < p.limit
+}
+
+// Close - writes the end of elements, where total is
+// the count of all container elements.
+func (p *Printer) Close(total int) {
+	if rest := total - p.count; rest > 0 {
+		if p.count > 0 {
+			_, _ = p.f.Write([]byte{' '})
+		}
+		_, _ = fmt.Fprintf(p.f, "... +%d more", rest)
+	}
+	_, _ = p.f.Write([]byte{']'})
+}
+
+// buildFormat - restores format string for single element from fmt.State.
+// If all is false, only '#' flag and width are kept.
+func buildFormat(f fmt.State, verb rune, all bool) string {
+	format := []byte{'%'}
+
+	flags := "#"
+	if all {
+		flags = "+-# 0"
+	}
+	for _, flag := range flags {
+		if f.Flag(int(flag)) {
+			format = append(format, byte(flag))
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		format = strconv.AppendInt(format, int64(width), 10)
+	}
+	if prec, ok := f.Precision(); ok && all {
+		format = append(format, '.')
+		format = strconv.AppendInt(format, int64(prec), 10)
+	}
+
+	return string(append(format, string(verb)...))
+}
+
+// FormatList - implements fmt.Formatter for linked lists,
+// writes values by Printer followed by ", Length(n)".
+// %#v writes GoString with provided constructor name.
+func FormatList[T any](f fmt.State, verb rune, constructor string, values iter.Seq[T], length int) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, GoString(constructor, values))
+		return
+	}
+
+	p := New(f, verb)
+	for v := range values {
+		if !p.Add(v) {
+			break
+		}
+	}
+	p.Close(length)
+
+	_, _ = fmt.Fprintf(f, ", Length(%v)", length)
+}
+
+// GoString - returns Go syntax of container construction from values,
+// as "singlylinkedlist.From[int](1, 2, 3)" for constructor
+// "singlylinkedlist.From".
+func GoString[T any](constructor string, values iter.Seq[T]) string {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "%s[%s](", constructor, reflect.TypeFor[T]())
+	first := true
+	for v := range values {
+		if !first {
+			b.WriteString(", ")
+		}
+		first = false
+		_, _ = fmt.Fprintf(&b, "%#v", v)
+	}
+	b.WriteByte(')')
+
+	return b.String()
+}
+
+// WriteJSON - writes values to w as JSON array, encoding them one by one,
+// so the output is the same as json.Marshal of their slice.
+// Returns count of written bytes.
+func WriteJSON[T any](w io.Writer, values iter.Seq[T]) (int64, error) {
+	cw := &countWriter{w: w}
+
+	cw.write([]byte{'['})
+	first := true
+	for v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return cw.n, err
+		}
+
+		if !first {
+			cw.write([]byte{','})
+		}
+		first = false
+		cw.write(data)
+	}
+	cw.write([]byte{']'})
+
+	return cw.n, cw.err
+}
+
+// WriteLink - writes value of linked Node in provided format,
+// or <nil> if v is nil, as there is no such Node.
+func WriteLink[T any](w io.Writer, format string, v *T) {
+	if v == nil {
+		_, _ = io.WriteString(w, "<nil>")
+		return
+	}
+	_, _ = fmt.Fprintf(w, format, *v)
+}
+
+// countWriter - counts written bytes and keeps the first error,
+// writes nothing after it.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) write(p []byte) {
+	if cw.err != nil {
+		return
+	}
+
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+}
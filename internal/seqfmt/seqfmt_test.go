@@ -0,0 +1,81 @@
+package seqfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// list - formats values as linked list.
+type list[T any] []T
+
+func (l list[T]) Format(f fmt.State, verb rune) {
+	FormatList(f, verb, "pkg.From", slices.Values(l), len(l))
+}
+
+func TestFormatList(t *testing.T) {
+	t.Parallel()
+
+	long := make(list[int], Limit+2)
+	formats := []struct{ got, exp string }{
+		{fmt.Sprint(list[int]{1, 2, 3}), "[1 2 3], Length(3)"},
+		{fmt.Sprint(list[int]{}), "[], Length(0)"},
+		{fmt.Sprintf("%.1v", list[int]{1, 2, 3}), "[1 ... +2 more], Length(3)"},
+		{fmt.Sprintf("%.0v", list[int]{1, 2}), "[... +2 more], Length(2)"},
+		{fmt.Sprintf("%02d", list[int]{1, 2, 3}), "[01 02 03], Length(3)"},
+		{fmt.Sprintf("%q", list[string]{"a"}), `["a"], Length(1)`},
+		{fmt.Sprintf("%#v", list[int]{1, 2}), "pkg.From[int](1, 2)"},
+		{fmt.Sprintf("%#v", list[string]{"a"}), `pkg.From[string]("a")`},
+		{fmt.Sprintf("%#v", list[int]{}), "pkg.From[int]()"},
+		{fmt.Sprint(long), "[0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 ... +2 more], Length(18)"},
+	}
+
+	for _, f := range formats {
+		if f.got != f.exp {
+			t.Fatalf("Expected string: %s\nGot: %s", f.exp, f.got)
+		}
+	}
+
+	if got := fmt.Sprintf("%+v", long); bytes.Contains([]byte(got), []byte("more")) {
+		t.Fatalf("Expected all values\nGot: %s", got)
+	}
+}
+
+// failWriter - accepts n bytes, then fails.
+type failWriter struct{ n int }
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, errors.New("write failed")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, values := range [][]any{nil, {1}, {"a", 2.5, nil, []int{1}}} {
+		var b bytes.Buffer
+		n, err := WriteJSON(&b, slices.Values(values))
+
+		exp, _ := json.Marshal(append([]any{}, values...))
+		if err != nil || b.String() != string(exp) || n != int64(len(exp)) {
+			t.Fatalf("Expected written: %s\nGot: %s, %d, %v", exp, b.String(), n, err)
+		}
+	}
+
+	if _, err := WriteJSON(&bytes.Buffer{}, slices.Values([]any{func() {}})); err == nil {
+		t.Fatal("Expected encoding error\nGot: nil")
+	}
+
+	n, err := WriteJSON(&failWriter{n: 3}, slices.Values([]int{10, 20}))
+	if err == nil || n != 3 {
+		t.Fatalf("Expected write error after: 3\nGot: %d, %v", n, err)
+	}
+}
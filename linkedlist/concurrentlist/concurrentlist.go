@@ -0,0 +1,197 @@
+// Package concurrentlist - concurrent-safety sorted doubly-linked list.
+package concurrentlist
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+	"sync/atomic"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Collection[int] = (*List[int])(nil)
+
+// List - represents a sorted doubly-linked list of unique values,
+// safe for concurrent use. Every Node is guarded by own mutex,
+// modifications lock Node's hand-over-hand from Head to Tail,
+// so goroutines working on different parts of List do not block each other.
+type List[T any] struct {
+	// head, tail - sentinel Node's, that never hold values.
+	head, tail *node[T]
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b T) int
+	length  atomic.Int64
+}
+
+// node - represents a List Node, all fields are guarded by mu.
+type node[T any] struct {
+	mu    sync.Mutex
+	value T
+	prev  *node[T]
+	next  *node[T]
+	// removed - marks Node, that is unlinked from List.
+	// Removed Node keeps its links, so iterators standing on it
+	// can continue traversal.
+	removed bool
+}
+
+// New - returns new List, ordered by natural order of values.
+func New[T cmp.Ordered]() *List[T] {
+	return NewFunc(cmp.Compare[T])
+}
+
+// NewFunc - returns new List, ordered by compare.
+func NewFunc[T any](compare func(a, b T) int) *List[T] {
+	head, tail := &node[T]{}, &node[T]{}
+	head.next, tail.prev = tail, head
+
+	return &List[T]{head: head, tail: tail, compare: compare}
+}
+
+// find - returns locked pair of neighbour Node's, where curr is the first
+// Node with value not less than v, or tail. Caller must unlock both.
+func (l *List[T]) find(v T) (pred, curr *node[T]) {
+	pred = l.head
+	pred.mu.Lock()
+	curr = pred.next
+	curr.mu.Lock()
+
+	for curr != l.tail && l.compare(curr.value, v) < 0 {
+		pred.mu.Unlock()
+		pred, curr = curr, curr.next
+		curr.mu.Lock()
+	}
+
+	return pred, curr
+}
+
+// Insert - adds v to List, returns false if v is already present.
+func (l *List[T]) Insert(v T) bool {
+	pred, curr := l.find(v)
+	defer pred.mu.Unlock()
+	defer curr.mu.Unlock()
+
+	if curr != l.tail && l.compare(curr.value, v) == 0 {
+		return false
+	}
+
+	n := &node[T]{value: v, prev: pred, next: curr}
+	pred.next, curr.prev = n, n
+	l.length.Add(1)
+	return true
+}
+
+// Add - inserts v, implements containers.Collection.
+func (l *List[T]) Add(v T) bool {
+	return l.Insert(v)
+}
+
+// Remove - removes v from List, returns false if v is not present.
+func (l *List[T]) Remove(v T) bool {
+	pred, curr := l.find(v)
+	defer pred.mu.Unlock()
+	defer curr.mu.Unlock()
+
+	if curr == l.tail || l.compare(curr.value, v) != 0 {
+		return false
+	}
+
+	l.unlink(pred, curr)
+	return true
+}
+
+// Contains - returns true, if v is present in List.
+func (l *List[T]) Contains(v T) bool {
+	pred, curr := l.find(v)
+	defer pred.mu.Unlock()
+	defer curr.mu.Unlock()
+
+	return curr != l.tail && l.compare(curr.value, v) == 0
+}
+
+// Len - returns count of List values.
+func (l *List[T]) Len() int {
+	return int(l.length.Load())
+}
+
+// IsEmpty - returns true, if List has no values.
+func (l *List[T]) IsEmpty() bool {
+	return l.Len() == 0
+}
+
+// Clear - removes all values from List.
+func (l *List[T]) Clear() {
+	l.head.mu.Lock()
+	defer l.head.mu.Unlock()
+
+	for {
+		curr := l.head.next
+		if curr == l.tail {
+			return
+		}
+
+		curr.mu.Lock()
+		l.unlink(l.head, curr)
+		curr.mu.Unlock()
+	}
+}
+
+// unlink - removes locked curr, that follows locked pred.
+func (l *List[T]) unlink(pred, curr *node[T]) {
+	succ := curr.next
+	succ.mu.Lock()
+
+	curr.removed = true
+	pred.next, succ.prev = succ, pred
+	l.length.Add(-1)
+
+	succ.mu.Unlock()
+}
+
+// All - returns weakly consistent iterator over values in increasing order.
+// Iteration does not block List, it never returns removed before visit
+// values, and returns values inserted during iteration, if they are
+// inserted ahead of iterator.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.walk(l.head, func(n *node[T]) *node[T] { return n.next }, yield)
+	}
+}
+
+// Backward - returns weakly consistent iterator over values
+// in decreasing order, see All.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.walk(l.tail, func(n *node[T]) *node[T] { return n.prev }, yield)
+	}
+}
+
+// ToSlice - returns snapshot of values in increasing order.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.Len())
+	for v := range l.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// walk - visits Node's from start, following step, until sentinel.
+// Only one Node is locked at a time and never while yield is called.
+func (l *List[T]) walk(start *node[T], step func(*node[T]) *node[T], yield func(T) bool) {
+	start.mu.Lock()
+	n := step(start)
+	start.mu.Unlock()
+
+	for n != l.head && n != l.tail {
+		n.mu.Lock()
+		v, removed, next := n.value, n.removed, step(n)
+		n.mu.Unlock()
+
+		if !removed && !yield(v) {
+			return
+		}
+		n = next
+	}
+}
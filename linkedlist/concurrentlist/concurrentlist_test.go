@@ -0,0 +1,129 @@
+package concurrentlist
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+const (
+	goroutines = 8
+	perRoutine = 200
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	list := New[int]()
+	for _, v := range []int{3, 1, 2, 3} {
+		list.Insert(v)
+	}
+
+	exp := []int{1, 2, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Len() != 3 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	exp = []int{3, 2, 1}
+	if got := slices.Collect(list.Backward()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	if !list.Contains(2) || list.Contains(4) {
+		t.Fatal("Expected contains 2 and not 4")
+	}
+
+	if !list.Remove(2) || list.Remove(2) || list.Contains(2) {
+		t.Fatal("Expected single remove of 2")
+	}
+
+	for v := range list.All() {
+		if v != 1 {
+			t.Fatalf("Expected first value: 1\nGot: %d", v)
+		}
+		break
+	}
+
+	list.Clear()
+	if !list.IsEmpty() || len(list.ToSlice()) != 0 {
+		t.Fatalf("Expected empty list\nGot: %v", list.ToSlice())
+	}
+}
+
+// TestStress - every goroutine inserts own range of values,
+// removes every second of them and checks the rest,
+// while readers iterate over List in both directions.
+func TestStress(t *testing.T) {
+	t.Parallel()
+
+	list := NewFunc(func(a, b int) int { return a - b })
+	done := make(chan struct{})
+
+	readers := sync.WaitGroup{}
+	for r := 0; r < 2; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				values := slices.Collect(list.All())
+				if !slices.IsSorted(values) {
+					t.Errorf("Expected sorted values\nGot: %v", values)
+					return
+				}
+
+				values = slices.Collect(list.Backward())
+				slices.Reverse(values)
+				if !slices.IsSorted(values) {
+					t.Errorf("Expected reversed sorted values\nGot: %v", values)
+					return
+				}
+			}
+		}()
+	}
+
+	writers := sync.WaitGroup{}
+	for g := 0; g < goroutines; g++ {
+		writers.Add(1)
+		go func(g int) {
+			defer writers.Done()
+
+			// interleaved ranges make goroutines work on close Node's
+			for i := 0; i < perRoutine; i++ {
+				if !list.Insert(i*goroutines + g) {
+					t.Errorf("Failed to insert: %d", i*goroutines+g)
+				}
+			}
+			for i := 0; i < perRoutine; i += 2 {
+				if !list.Remove(i*goroutines + g) {
+					t.Errorf("Failed to remove: %d", i*goroutines+g)
+				}
+			}
+			for i := 0; i < perRoutine; i++ {
+				if list.Contains(i*goroutines+g) != (i%2 == 1) {
+					t.Errorf("Unexpected presence of: %d", i*goroutines+g)
+				}
+			}
+		}(g)
+	}
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	values := list.ToSlice()
+	if len(values) != goroutines*perRoutine/2 || list.Len() != len(values) {
+		t.Fatalf("Expected length: %d\nGot: %d, %d", goroutines*perRoutine/2, len(values), list.Len())
+	}
+	for _, v := range values {
+		if (v/goroutines)%2 != 1 {
+			t.Fatalf("Unexpected value: %d", v)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package doublylinkedlist
+
+// Reverse - reverses order of List Node's in place in O(n).
+func (l *List[T]) Reverse() {
+	for ptr := l.Head; ptr != nil; ptr = ptr.Prev {
+		ptr.Prev, ptr.Next = ptr.Next, ptr.Prev
+	}
+	l.Head, l.Tail = l.Tail, l.Head
+}
+
+// MergeSort - sorts List in place in O(n*log(n)), keeping order of equal values.
+// compare returns negative number if a < b, positive if a > b,
+// and zero if they are equal.
+func (l *List[T]) MergeSort(compare func(a, b T) int) {
+	l.relink(mergeSort(l.Head, l.Length, compare))
+}
+
+// Merge - merges sorted other List into sorted List in O(n+m),
+// on equal values List Node's go first. other becomes empty.
+func (l *List[T]) Merge(other *List[T], compare func(a, b T) int) {
+	if other == l || other.Head == nil {
+		return
+	}
+
+	other.own.Forward(l.owner())
+	l.Length += other.Length
+	l.relink(merge(l.Head, other.Head, compare))
+
+	other.Head, other.Tail, other.Length = nil, nil, 0
+}
+
+// Concat - moves all Node's of other List to the back of List in O(1).
+// other becomes empty.
+func (l *List[T]) Concat(other *List[T]) {
+	l.Splice(other, l.Tail)
+}
+
+// Splice - moves all Node's of other List after mark in O(1),
+// or to the front of List, if mark is nil. other becomes empty.
+// Returns false, if mark is not an element of List, or other is List itself.
+func (l *List[T]) Splice(other *List[T], mark *Node[T]) bool {
+	if other == l || mark != nil && !l.owns(mark) {
+		return false
+	}
+	if other.Head == nil {
+		return true
+	}
+
+	first, last := other.Head, other.Tail
+
+	var next *Node[T]
+	if mark == nil {
+		next, l.Head = l.Head, first
+	} else {
+		next, mark.Next = mark.Next, first
+	}
+	first.Prev, last.Next = mark, next
+
+	if next != nil {
+		next.Prev = last
+	} else {
+		l.Tail = last
+	}
+
+	other.own.Forward(l.owner())
+	l.Length += other.Length
+
+	other.Head, other.Tail, other.Length = nil, nil, 0
+	return true
+}
+
+// Split - cuts List at index i, where 0 <= i <= Length,
+// List keeps Node's before i, the rest are returned as new List.
+// Returns nil if i is out of range.
+func (l *List[T]) Split(i int) *List[T] {
+	if i < 0 || i > l.Length {
+		return nil
+	}
+
+	rest := New(WithPool(l.pool))
+	prev := l.NodeAt(i - 1)
+
+	if prev == nil {
+		rest.Head, l.Head = l.Head, nil
+	} else {
+		rest.Head, prev.Next = prev.Next, nil
+	}
+
+	if rest.Head != nil {
+		rest.Head.Prev = nil
+		rest.Tail = l.Tail
+	}
+	rest.Length = l.Length - i
+	l.Tail, l.Length = prev, i
+
+	for ptr := rest.Head; ptr != nil; ptr = ptr.Next {
+		ptr.owner = rest.owner()
+	}
+	return rest
+}
+
+// SplitHalves - cuts List into halves, List keeps the first one,
+// that is longer by one Node for odd Length. Returns the second half.
+func (l *List[T]) SplitHalves() *List[T] {
+	return l.Split((l.Length + 1) / 2)
+}
+
+// relink - sets new head of List, restores Prev links and Tail
+// after Node's were relinked by Next links only.
+func (l *List[T]) relink(head *Node[T]) {
+	l.Head, l.Tail = head, nil
+
+	var prev *Node[T]
+	for ptr := head; ptr != nil; ptr = ptr.Next {
+		ptr.Prev, prev = prev, ptr
+	}
+	l.Tail = prev
+}
+
+// mergeSort - sorts n Node's by Next links, starting from head,
+// where the last one has no Next Node. Returns new head.
+func mergeSort[T any](head *Node[T], n int, compare func(a, b T) int) *Node[T] {
+	if n < 2 {
+		return head
+	}
+
+	mid := head
+	for i := 1; i < n/2; i++ {
+		mid = mid.Next
+	}
+	right := mid.Next
+	mid.Next = nil
+
+	return merge(
+		mergeSort(head, n/2, compare),
+		mergeSort(right, n-n/2, compare),
+		compare,
+	)
+}
+
+// merge - merges two sorted chains of Node's by Next links,
+// on equal values Node's of a go first. Returns new head.
+func merge[T any](a, b *Node[T], compare func(a, b T) int) *Node[T] {
+	var head Node[T]
+	tail := &head
+
+	for a != nil && b != nil {
+		if compare(a.Value, b.Value) <= 0 {
+			tail.Next, a = a, a.Next
+		} else {
+			tail.Next, b = b, b.Next
+		}
+		tail = tail.Next
+	}
+
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+	return head.Next
+}
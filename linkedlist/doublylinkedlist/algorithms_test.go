@@ -0,0 +1,224 @@
+package doublylinkedlist
+
+import (
+	"cmp"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3)
+	list.Reverse()
+
+	exp := []int{3, 2, 1}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Tail.Value != 1 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, list)
+
+	empty := New[int]()
+	empty.Reverse()
+	if empty.Head != nil || empty.Tail != nil {
+		t.Fatal("Expected empty list\nGot: ", empty)
+	}
+}
+
+func TestMergeSort(t *testing.T) {
+	t.Parallel()
+
+	type pair struct{ key, order int }
+	byKey := func(a, b pair) int { return cmp.Compare(a.key, b.key) }
+
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 50; n++ {
+		values := make([]pair, n)
+		for i := range values {
+			values[i] = pair{rnd.Intn(5), i}
+		}
+
+		list := From(values...)
+		list.MergeSort(byKey)
+		slices.SortStableFunc(values, byKey)
+
+		if got := list.ToSlice(); !reflect.DeepEqual(values, got) {
+			t.Fatalf("Expected stable sorted: %v\nGot: %v", values, got)
+		}
+		if list.Length != n {
+			t.Fatalf("Expected length: %d\nGot: %d", n, list.Length)
+		}
+		checkLinks(t, list)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	list, other := From(1, 3, 5, 7), From(2, 3, 4)
+	foreign := other.Head
+	list.Merge(other, cmp.Compare[int])
+
+	exp := []int{1, 2, 3, 3, 4, 5, 7}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != len(exp) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, list)
+
+	if other.Head != nil || other.Length != 0 {
+		t.Fatal("Expected empty other list\nGot: ", other)
+	}
+	if _, ok := list.Remove(foreign); !ok {
+		t.Fatal("Expected merged node owned by list")
+	}
+
+	list, other = From(5), From(1, 2)
+	list.Merge(other, cmp.Compare[int])
+	if list.Tail.Value != 5 || list.Head.Value != 1 {
+		t.Fatal("Expected list (head 1, tail 5)\nGot: ", list.ToSlice())
+	}
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3, 4, 5)
+	rest := list.SplitHalves()
+
+	if exp, got := []int{1, 2, 3}, list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != 3 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	if exp, got := []int{4, 5}, rest.ToSlice(); !reflect.DeepEqual(exp, got) || rest.Length != 2 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, list)
+	checkLinks(t, rest)
+
+	if _, ok := list.Remove(rest.Head); ok {
+		t.Fatal("Expected split node not owned by list")
+	}
+
+	all := list.Split(0)
+	if list.Head != nil || list.Tail != nil || all.Length != 3 {
+		t.Fatal("Expected all nodes moved\nGot: ", list, all)
+	}
+
+	if none := all.Split(3); none.Length != 0 || all.Length != 3 || all.Split(4) != nil {
+		t.Fatal("Expected empty split at end and nil out of range")
+	}
+}
+
+func TestConcatSplice(t *testing.T) {
+	t.Parallel()
+
+	list, other := From(1, 2), From(3, 4)
+	foreign := other.Head
+	list.Concat(other)
+
+	exp := []int{1, 2, 3, 4}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || other.Length != 0 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, list)
+
+	if list.InsertAfter(5, foreign) == nil {
+		t.Fatal("Expected concatenated node owned by list")
+	}
+
+	// forwarded twice
+	outer := From(0)
+	outer.Concat(list)
+	if _, ok := outer.Remove(foreign); !ok {
+		t.Fatal("Expected twice concatenated node owned by outer list")
+	}
+
+	if !outer.Splice(From(-2, -1), nil) || !outer.Splice(From(9), outer.Tail) {
+		t.Fatal("Expected splice to succeed")
+	}
+
+	exp = []int{-2, -1, 0, 1, 2, 5, 4, 9}
+	if got := outer.ToSlice(); !reflect.DeepEqual(exp, got) || outer.Tail.Value != 9 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, outer)
+
+	if outer.Splice(From(1), foreign) || outer.Splice(outer, nil) {
+		t.Fatal("Expected failed splice after foreign node or of itself")
+	}
+
+	kept := outer.Head
+	outer.Clear()
+	if outer.InsertAfter(1, kept) != nil {
+		t.Fatal("Expected nodes of cleared list not owned")
+	}
+}
+
+// checkLinks - checks Head, Tail, Length and Prev links consistency.
+func checkLinks[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+
+	count, last := 0, (*Node[T])(nil)
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		if ptr.Prev != last {
+			t.Fatalf("Expected prev of node %d: %v\nGot: %v", count, last, ptr.Prev)
+		}
+		count, last = count+1, ptr
+	}
+
+	if count != l.Length || last != l.Tail {
+		t.Fatalf("Expected length: %d, tail: %v\nGot: %d, %v", count, last, l.Length, l.Tail)
+	}
+}
+
+func TestTailInvariant(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(2))
+	l := New[int]()
+	var model []int
+
+	for op := range 2000 {
+		switch rnd.Intn(8) {
+		case 0, 1:
+			l.Append(op)
+			model = append(model, op)
+		case 2:
+			if len(model) > 0 {
+				i := rnd.Intn(len(model))
+				l.InsertBefore(op, l.NodeAt(i))
+				model = slices.Insert(model, i, op)
+			}
+		case 3:
+			i := rnd.Intn(len(model) + 1)
+			l.InsertAt(i, op)
+			model = slices.Insert(model, i, op)
+		case 4:
+			if len(model) > 0 {
+				i := rnd.Intn(len(model))
+				l.Remove(l.NodeAt(i))
+				model = slices.Delete(model, i, i+1)
+			}
+		case 5:
+			if len(model) > 0 {
+				i := rnd.Intn(len(model))
+				v := model[i]
+				l.MoveToFront(l.NodeAt(i))
+				model = slices.Insert(slices.Delete(model, i, i+1), 0, v)
+			}
+		case 6:
+			l.Reverse()
+			slices.Reverse(model)
+		case 7:
+			rest := l.Split(rnd.Intn(len(model) + 1))
+			checkLinks(t, rest)
+			l.Concat(rest)
+		}
+
+		checkLinks(t, l)
+		if op%100 == 0 && !slices.Equal(l.ToSlice(), model) {
+			t.Fatalf("Expected: %v\nGot: %v", model, l.ToSlice())
+		}
+	}
+}
@@ -1,6 +1,13 @@
 package doublylinkedlist
 
-import "fmt"
+import (
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/ownership"
+)
+
+var _ containers.Sequence[any] = (*List[any])(nil)
 
 // List represents a doubly-linked List
 // that holds values of any type.
@@ -8,6 +15,11 @@ type List[T any] struct {
 	Head   *Node[T]
 	Tail   *Node[T]
 	Length int
+
+	// own - ownership token of List Node's.
+	own ownership.Owner[List[T]]
+	// pool - allocator of List Node's, nil for heap allocation.
+	pool *Pool[T]
 }
 
 // Node represents a doubly-linked Node
@@ -16,175 +28,286 @@ type Node[T any] struct {
 	Prev  *Node[T]
 	Value T
 	Next  *Node[T]
+
+	// owner - ownership token of List the Node belongs to,
+	// nil for Node's created by AddNode.
+	owner *ownership.Token[List[T]]
+}
+
+// owner - returns ownership token of List, creates it if needed.
+func (l *List[T]) owner() *ownership.Token[List[T]] {
+	return l.own.Token(l)
 }
 
-// New - returns new List.
-func New[T any]() *List[T] {
-	head := &Node[T]{}
+// owns - returns true, if n is an element of List.
+func (l *List[T]) owns(n *Node[T]) bool {
+	return n != nil && n.owner.Resolve() == l
+}
+
+// New - returns new empty List, configured with opts.
+// Zero value of List is an empty List too.
+func New[T any](opts ...Option[T]) *List[T] {
+	l := &List[T]{}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// From - returns List of provided values, from Head to Tail.
+func From[T any](values ...T) *List[T] {
+	l := New[T]()
+	for _, v := range values {
+		l.Append(v)
+	}
+	return l
+}
 
-	return &List[T]{
-		Head:   head,
-		Length: 0,
-		Tail:   head.Next,
+// FromSeq - returns List of values, collected from seq.
+func FromSeq[T any](seq iter.Seq[T]) *List[T] {
+	l := New[T]()
+	for v := range seq {
+		l.Append(v)
 	}
+	return l
 }
 
-// Append - adds new Tail to List, after last Tail.
+// Append - adds new Tail to List, after last Tail in O(1).
 func (l *List[T]) Append(v T) {
-	if l.Head == nil {
-		return
+	l.insertNode(l.newNode(v), l.Tail)
+}
+
+// PushBack - the same as Append.
+func (l *List[T]) PushBack(v T) {
+	l.Append(v)
+}
+
+// Prepend - adds new Head to List, before first Head in O(1).
+func (l *List[T]) Prepend(v T) {
+	l.insertNode(l.newNode(v), nil)
+}
+
+// PushFront - the same as Prepend.
+func (l *List[T]) PushFront(v T) {
+	l.Prepend(v)
+}
+
+// InsertAfter - adds new Node with value v after mark, returns new Node.
+// If mark is not an element of List, List is not modified and nil is returned.
+func (l *List[T]) InsertAfter(v T, mark *Node[T]) *Node[T] {
+	if !l.owns(mark) {
+		return nil
 	}
+	return l.insertNode(l.newNode(v), mark)
+}
 
-	ptr := l.Head
-	tail := AddNode(ptr, nil, v)
+// InsertBefore - adds new Node with value v before mark, returns new Node.
+// If mark is not an element of List, List is not modified and nil is returned.
+func (l *List[T]) InsertBefore(v T, mark *Node[T]) *Node[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.insertNode(l.newNode(v), mark.Prev)
+}
 
-	for {
-		if ptr.Next == nil {
-			ptr.Next = tail
-			break
-		}
-		ptr = ptr.Next
+// Remove - removes n from List, returns its value and true.
+// If n is not an element of List, returns zero value and false.
+func (l *List[T]) Remove(n *Node[T]) (T, bool) {
+	if !l.owns(n) {
+		var zero T
+		return zero, false
 	}
 
-	l.Tail = tail
-	l.Length++
+	v := n.Value
+	l.unlink(n)
+	l.release(n)
+	return v, true
 }
 
-// Insert - adds new Node, on position after current Node.
-// If any Node exists next to current, it becomes next to new.
-// Method disconnected with List type, will not affect Tail or Length.
-func (l *Node[T]) Insert(v T) {
-	if l == nil {
+// MoveToFront - moves n to the front of List.
+// If n is not an element of List, List is not modified.
+func (l *List[T]) MoveToFront(n *Node[T]) {
+	if !l.owns(n) || l.Head == n {
 		return
 	}
 
-	l.Next = AddNode[T](l, l.Next, v)
-	l.Next.Next.Prev = l.Next
+	l.unlink(n)
+	l.insertNode(n, nil)
 }
 
-// AddNode - returns new Node with provided parameters.
-func AddNode[T any](prev, next *Node[T], value T) *Node[T] {
-	return &Node[T]{
-		Prev:  prev,
-		Value: value,
-		Next:  next,
+// MoveToBack - moves n to the back of List.
+// If n is not an element of List, List is not modified.
+func (l *List[T]) MoveToBack(n *Node[T]) {
+	if !l.owns(n) || l.Tail == n {
+		return
 	}
-}
 
-// FillWithRange - generate List from provided range.
-func FillWithRange(l *List[int], from, to int) {
-	l.Head.Value = from
-	l.Length++
+	l.unlink(n)
+	l.insertNode(n, l.Tail)
+}
 
-	ptr := l.Head
-	for i := from + 1; i <= to; i++ {
-		if ptr.Next == nil {
-			ptr.Next = AddNode[int](ptr, nil, i)
-			l.Tail = ptr.Next
-			l.Length++
-		}
-		ptr = ptr.Next
+// insertNode - links n after provided Node, or as Head if after is nil.
+// Keeps Head, Tail and Length consistent, returns n.
+func (l *List[T]) insertNode(n, after *Node[T]) *Node[T] {
+	if after == nil {
+		n.Prev, n.Next = nil, l.Head
+		l.Head = n
+	} else {
+		n.Prev, n.Next = after, after.Next
+		after.Next = n
 	}
-}
 
-// FillWithStrings - generate List from provided strings.
-func FillWithStrings(l *List[string], s ...string) {
-	if len(s) == 0 {
-		return
+	if n.Next != nil {
+		n.Next.Prev = n
+	} else {
+		l.Tail = n
 	}
 
-	l.Head.Value = s[0]
+	n.owner = l.owner()
 	l.Length++
+	return n
+}
 
-	ptr := l.Head
-	for i, el := range s[1:] {
-		if ptr.Next == nil {
-			node := AddNode[string](ptr, nil, el)
-			ptr.Next = node
-			l.Length++
+// unlink - removes n from List, keeping Head, Tail and Length consistent.
+func (l *List[T]) unlink(n *Node[T]) {
+	if n.Prev != nil {
+		n.Prev.Next = n.Next
+	} else {
+		l.Head = n.Next
+	}
 
-			if i == len(s[1:])-1 {
-				l.Tail = node
-			}
-		}
-		ptr = ptr.Next
+	if n.Next != nil {
+		n.Next.Prev = n.Prev
+	} else {
+		l.Tail = n.Prev
 	}
+
+	n.Prev, n.Next, n.owner = nil, nil, nil
+	l.Length--
+}
+
+// Add - appends value to List, implements containers.Collection.
+func (l *List[T]) Add(v T) bool {
+	l.Append(v)
+	return true
 }
 
-// PrintList - prints all Node's, from Head to Tail.
-func (l *List[T]) PrintList() {
-	ptr := l.Head
+// Len - returns count of List elements.
+func (l *List[T]) Len() int {
+	return l.Length
+}
+
+// IsEmpty - returns true, if List has no elements.
+func (l *List[T]) IsEmpty() bool {
+	return l.Length == 0
+}
 
-	for {
-		ptr.PrintNode()
-		if ptr.Next == nil {
-			break
+// Clear - removes all elements from List in O(1),
+// or in O(n) if List returns Node's to Pool.
+func (l *List[T]) Clear() {
+	if l.pool != nil {
+		for ptr := l.Head; ptr != nil; {
+			next := ptr.Next
+			l.release(ptr)
+			ptr = next
 		}
-		ptr = ptr.Next
 	}
 
-	fmt.Println("Length:", l.Length)
+	l.own.Release()
+	l.Head, l.Tail, l.Length = nil, nil, 0
 }
 
-// PrintNode - print Node Value, and its Next Node Value.
-func (l *Node[T]) PrintNode() {
-	if l == nil {
-		fmt.Print(nil)
-		return
+// Front - returns Head value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Front() (T, bool) {
+	if l.Head == nil {
+		var zero T
+		return zero, false
 	}
+	return l.Head.Value, true
+}
 
-	fmt.Printf("%v<-%v->%v ",
-		func() interface{} {
-			if l.Prev != nil {
-				return l.Prev.Value
-			}
-			return nil
-		}(),
-		l.Value,
-		func() interface{} {
-			if l.Next != nil {
-				return l.Next.Value
-			}
-			return nil
-		}(),
-	)
+// Back - returns Tail value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Back() (T, bool) {
+	if l.Tail == nil {
+		var zero T
+		return zero, false
+	}
+	return l.Tail.Value, true
 }
 
-// PrintListReversed - prints all Node's in reversed order, from Tail to Head.
-func (l *List[T]) PrintListReversed() {
-	ptr := l.Tail
+// ToSlice - returns values of all Node's, from Head to Tail.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.Length)
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		values = append(values, ptr.Value)
+	}
+	return values
+}
 
-	for {
-		ptr.PrintNodeReversed()
-		if ptr.Prev == nil {
-			break
+// All - returns iterator over Node's values with their indexes, from Head to Tail.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+			if !yield(i, ptr.Value) {
+				return
+			}
+			i++
 		}
-		ptr = ptr.Prev
 	}
+}
 
-	fmt.Println("Length:", l.Length)
+// Values - returns iterator over Node's values, from Head to Tail.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+			if !yield(ptr.Value) {
+				return
+			}
+		}
+	}
 }
 
-// PrintNodeReversed - print Node Value, and its Next Node Value, in reversed order.
-func (l *Node[T]) PrintNodeReversed() {
+// Backward - returns iterator over Node's values with their indexes,
+// from Tail to Head.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.Length - 1
+		for ptr := l.Tail; ptr != nil; ptr = ptr.Prev {
+			if !yield(i, ptr.Value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Insert - adds new Node, on position after current Node.
+// If any Node exists next to current, it becomes next to new.
+// If current Node belongs to List, its Tail and Length are updated.
+func (l *Node[T]) Insert(v T) {
 	if l == nil {
-		fmt.Print(nil)
 		return
 	}
 
-	fmt.Printf("%v<-%v->%v ",
-		func() interface{} {
-			if l.Next != nil {
-				return l.Next.Value
-			}
-			return nil
-		}(),
-		l.Value,
-		func() interface{} {
-			if l.Prev != nil {
-				return l.Prev.Value
-			}
-			return nil
-		}(),
-	)
+	if list := l.owner.Resolve(); list != nil {
+		list.InsertAfter(v, l)
+		return
+	}
+
+	l.Next = AddNode[T](l, l.Next, v)
+	if l.Next.Next != nil {
+		l.Next.Next.Prev = l.Next
+	}
+}
+
+// AddNode - returns new Node with provided parameters.
+func AddNode[T any](prev, next *Node[T], value T) *Node[T] {
+	return &Node[T]{
+		Prev:  prev,
+		Value: value,
+		Next:  next,
+	}
 }
@@ -1,37 +1,38 @@
 package doublylinkedlist
 
 import (
+	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 )
 
 func TestNew(t *testing.T) {
 	t.Parallel()
 
-	expNode := &Node[string]{}
-	expList := &List[string]{
-		Head:   expNode,
-		Tail:   expNode.Next,
-		Length: 0,
-	}
-
+	expList := &List[string]{}
 	list := New[string]()
 
 	if !reflect.DeepEqual(expList, list) {
 		t.Fatalf("Expected list: %v\nGot: %v", expList, list)
 	}
+
+	list.Append("1")
+	if list.Head != list.Tail || list.Head.Value != "1" || list.Length != 1 {
+		t.Fatal("Expected list (head == tail == 1, length 1)\nGot: ", list)
+	}
 }
 
 func TestAppend(t *testing.T) {
 	t.Parallel()
 
-	expList := &List[int8]{}
-	list := New[int8]()
-	list.Head = nil
+	node := AddNode[int8](nil, nil, 1)
+	expList := &List[int8]{Head: node, Tail: node, Length: 1}
+	list := &List[int8]{}
 
 	list.Append(1)
 
-	if !reflect.DeepEqual(expList, list) {
+	if !reflect.DeepEqual(own(expList), list) {
 		t.Fatalf("Expected list: %v\nGot: %v", expList, list)
 	}
 
@@ -39,11 +40,37 @@ func TestAppend(t *testing.T) {
 	list.Append(15)
 	list.Append(15)
 
+	if list.Length != 2 || list.Head.Value != 15 {
+		t.Fatal("Expected list (length 2, head 15)\nGot: ", list)
+	}
+
 	if list.Tail == nil || list.Length == 0 {
 		t.Fatal("Expected list (length > 0, tail != nil)\nGot: ", list)
 	}
 }
 
+func TestPrepend(t *testing.T) {
+	t.Parallel()
+
+	list := &List[int]{}
+	list.Prepend(2)
+	list.PushBack(3)
+	list.PushFront(1)
+
+	exp := []int{1, 2, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != len(exp) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	if list.Tail.Next != nil || list.Tail.Prev.Value != 2 || list.Head.Next.Prev != list.Head {
+		t.Fatal("Expected linked list (tail prev 2, head next prev head)\nGot: ", list)
+	}
+
+	if list.Head.Value != 1 || list.Tail.Value != 3 {
+		t.Fatal("Expected list (head 1, tail 3)\nGot: ", list)
+	}
+}
+
 func TestInsert(t *testing.T) {
 	t.Parallel()
 
@@ -51,8 +78,7 @@ func TestInsert(t *testing.T) {
 	var node *Node[any]
 	node.Insert(1)
 
-	expList := New[int]()
-	FillWithRange(expList, 1, 3)
+	expList := From(1, 2, 3)
 
 	list := &Node[int]{
 		Value: 1,
@@ -84,9 +110,14 @@ func TestAddNode(t *testing.T) {
 	}
 }
 
-func TestFillWithRange(t *testing.T) {
+func TestFrom(t *testing.T) {
 	t.Parallel()
 
+	list := From[int]()
+	if list.Length != 0 || list.Head != nil || list.Tail != nil {
+		t.Fatal("Expected empty list\nGot:", list)
+	}
+
 	expList := &List[int]{}
 	expList.Head = AddNode[int](nil, nil, 1)
 	expList.Head.Next = AddNode[int](expList.Head, nil, 2)
@@ -94,35 +125,178 @@ func TestFillWithRange(t *testing.T) {
 	expList.Head.Next.Next = tail
 	expList.Tail, expList.Length = tail, 3
 
-	list := New[int]()
-	FillWithRange(list, 1, 3)
+	list = From(1, 2, 3)
 
-	if !reflect.DeepEqual(expList, list) {
+	if !reflect.DeepEqual(own(expList), list) {
 		t.Fatalf("Expected list: %v\nGot: %v", expList, list)
 	}
 }
 
-func TestFillWithStrings(t *testing.T) {
+func TestFromSeq(t *testing.T) {
 	t.Parallel()
 
-	list := New[string]()
-	FillWithStrings(list)
+	list := FromSeq(slices.Values([]string{"1", "2", "3"}))
 
-	if list.Length != 0 || list.Tail != nil {
-		t.Fatal("Expected list(length > 0, tail != nil)\nGot:", list)
+	exp := []string{"1", "2", "3"}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != 3 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
 	}
 
-	expList := &List[string]{}
-	expList.Head = AddNode[string](nil, nil, "1")
-	expList.Head.Next = AddNode[string](expList.Head, nil, "2")
-	tail := AddNode[string](expList.Head.Next, nil, "3")
-	expList.Head.Next.Next = tail
-	expList.Tail, expList.Length = tail, 3
+	if list.Tail.Value != "3" {
+		t.Fatalf("Expected tail: 3\nGot: %v", list.Tail.Value)
+	}
+}
 
-	list = New[string]()
-	FillWithStrings(list, "1", "2", "3")
+func TestIterators(t *testing.T) {
+	t.Parallel()
 
-	if !reflect.DeepEqual(expList, list) {
-		t.Fatalf("Expected list: %v\nGot: %v", expList, list)
+	list := From(1, 2, 3, 4, 5)
+
+	var got []int
+	for i, v := range list.All() {
+		if i == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp := []int{1, 2, 3}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	got = got[:0]
+	for i, v := range list.Backward() {
+		if v != i+1 {
+			t.Fatalf("Expected value at %d: %d\nGot: %d", i, i+1, v)
+		}
+		if i == 1 {
+			break
+		}
+		got = append(got, v)
+	}
+	for v := range list.Values() {
+		if v == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp = []int{5, 4, 3, 1}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+}
+
+func BenchmarkAppend(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("input %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				list := &List[int]{}
+				for v := 0; v < n; v++ {
+					list.Append(v)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+	}
+}
+
+// own - binds all Node's of expected List to it.
+func own[T any](l *List[T]) *List[T] {
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		ptr.owner = l.owner()
+	}
+	return l
+}
+
+func TestListInsertRemove(t *testing.T) {
+	t.Parallel()
+
+	list := &List[int]{}
+	list.Append(2)
+	four := list.InsertAfter(4, list.Head)
+	list.InsertBefore(3, four)
+	list.InsertBefore(1, list.Head)
+	list.Tail.Insert(5)
+
+	exp := []int{1, 2, 3, 4, 5}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != len(exp) {
+		t.Fatalf("Expected values: %v\nGot: %v, length %d", exp, got, list.Length)
+	}
+	if list.Tail.Value != 5 {
+		t.Fatalf("Expected tail: 5\nGot: %v", list.Tail.Value)
+	}
+
+	if v, ok := list.Remove(list.Tail); !ok || v != 5 || list.Tail != four {
+		t.Fatalf("Expected removed tail: 5, new tail 4\nGot: %d, %v", v, list.Tail.Value)
+	}
+	if v, ok := list.Remove(list.Head); !ok || v != 1 || list.Head.Value != 2 {
+		t.Fatalf("Expected removed head: 1, new head 2\nGot: %d, %v", v, list.Head.Value)
+	}
+
+	exp = []int{2, 3, 4}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != len(exp) {
+		t.Fatalf("Expected values: %v\nGot: %v, length %d", exp, got, list.Length)
+	}
+
+	list.MoveToFront(four)
+	list.MoveToBack(list.Head.Next)
+
+	exp = []int{4, 3, 2}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Tail.Value != 2 {
+		t.Fatalf("Expected values: %v\nGot: %v, tail %v", exp, got, list.Tail.Value)
+	}
+
+	for list.Length > 0 {
+		list.Remove(list.Head)
+	}
+	if list.Head != nil || list.Tail != nil {
+		t.Fatal("Expected empty list\nGot: ", list)
+	}
+}
+
+func TestListOwnership(t *testing.T) {
+	t.Parallel()
+
+	list, other := &List[int]{}, &List[int]{}
+	list.Append(1)
+	other.Append(2)
+	foreign := other.Head
+
+	if list.InsertAfter(3, foreign) != nil || list.InsertBefore(3, foreign) != nil {
+		t.Fatal("Expected nil insert around foreign node")
+	}
+	if _, ok := list.Remove(foreign); ok {
+		t.Fatal("Expected failed remove of foreign node")
+	}
+
+	list.MoveToFront(foreign)
+	list.MoveToBack(foreign)
+	if list.Length != 1 || other.Length != 1 || other.Head != foreign {
+		t.Fatal("Expected unmodified lists\nGot: ", list, other)
+	}
+
+	removed := list.Head
+	list.Remove(removed)
+	if _, ok := list.Remove(removed); ok {
+		t.Fatal("Expected failed second remove")
+	}
+
+	other.Clear()
+	if _, ok := other.Remove(foreign); ok || !other.IsEmpty() {
+		t.Fatal("Expected failed remove of cleared node")
+	}
+}
+
+func TestInsertAfterLast(t *testing.T) {
+	t.Parallel()
+
+	node := AddNode[int](nil, nil, 1)
+	node.Insert(2)
+
+	if node.Next == nil || node.Next.Prev != node || node.Next.Next != nil {
+		t.Fatal("Expected linked detached nodes\nGot: ", node, node.Next)
 	}
 }
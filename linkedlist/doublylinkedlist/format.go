@@ -0,0 +1,82 @@
+package doublylinkedlist
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/seriozhakorneev/go-data-structures/internal/seqfmt"
+)
+
+// String - returns List values from Head to Tail and its Length.
+// Long List is truncated, see Format.
+func (l *List[T]) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+// Format - implements fmt.Formatter.
+// %v and %s print up to seqfmt.Limit values, %+v prints all of them,
+// precision (%.5v) sets own limit, %#v prints GoString.
+// Other verbs are applied to every value.
+func (l *List[T]) Format(f fmt.State, verb rune) {
+	seqfmt.FormatList(f, verb, "doublylinkedlist.From", l.Values(), l.Length)
+}
+
+// GoString - returns Go syntax of List construction,
+// as "doublylinkedlist.From[int](1, 2, 3)".
+func (l *List[T]) GoString() string {
+	return seqfmt.GoString("doublylinkedlist.From", l.Values())
+}
+
+// WriteTo - implements io.WriterTo, writes List values from Head to Tail
+// as JSON array, the same as MarshalJSON, without collecting them.
+func (l *List[T]) WriteTo(w io.Writer) (int64, error) {
+	return seqfmt.WriteJSON(w, l.Values())
+}
+
+// MarshalJSON - implements json.Marshaler,
+// returns List values from Head to Tail as JSON array.
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.ToSlice())
+}
+
+// UnmarshalJSON - implements json.Unmarshaler,
+// replaces List values with JSON array.
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	l.Clear()
+	for _, v := range values {
+		l.Append(v)
+	}
+	return nil
+}
+
+// String - returns Node Value with its Prev and Next Node Values as "1<-2->3".
+func (n *Node[T]) String() string {
+	return fmt.Sprintf("%v", n)
+}
+
+// Format - implements fmt.Formatter, provided verb is applied to values.
+func (n *Node[T]) Format(f fmt.State, verb rune) {
+	if n == nil {
+		_, _ = io.WriteString(f, "<nil>")
+		return
+	}
+
+	format := fmt.FormatString(f, verb)
+	seqfmt.WriteLink(f, format, n.Prev.value())
+	_, _ = fmt.Fprintf(f, "<-"+format+"->", n.Value)
+	seqfmt.WriteLink(f, format, n.Next.value())
+}
+
+// value - returns pointer to Value of n, or nil if n is nil.
+func (n *Node[T]) value() *T {
+	if n == nil {
+		return nil
+	}
+	return &n.Value
+}
@@ -0,0 +1,55 @@
+package doublylinkedlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3)
+	formats := []struct{ got, exp string }{
+		{list.String(), "[1 2 3], Length(3)"},
+		{fmt.Sprintf("%#v", list), "doublylinkedlist.From[int](1, 2, 3)"},
+		{list.Head.Next.String(), "1<-2->3"},
+		{fmt.Sprintf("%02d", list.Tail.Prev), "01<-02->03"},
+		{list.Head.String(), "<nil><-1->2"},
+		{list.Tail.String(), "2<-3-><nil>"},
+		{fmt.Sprint((*Node[int])(nil)), "<nil>"},
+	}
+
+	for _, f := range formats {
+		if f.got != f.exp {
+			t.Fatalf("Expected string: %s\nGot: %s", f.exp, f.got)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	list := From(3, 1, 2)
+	list.Reverse()
+
+	var b bytes.Buffer
+	if _, err := list.WriteTo(&b); err != nil || b.String() != "[2,1,3]" {
+		t.Fatalf("Expected written: [2,1,3]\nGot: %s, %v", b.String(), err)
+	}
+	if data, _ := json.Marshal(list); string(data) != b.String() {
+		t.Fatalf("Expected json: %s\nGot: %s", b.String(), data)
+	}
+
+	if err := json.Unmarshal(b.Bytes(), list); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	checkLinks(t, list)
+
+	exp := []int{2, 1, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Tail.Prev.Value != 1 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+}
@@ -0,0 +1,51 @@
+package doublylinkedlist
+
+import "github.com/seriozhakorneev/go-data-structures/internal/nodepool"
+
+// DefaultSlabSize - count of Node's, allocated by Pool at once by default.
+const DefaultSlabSize = nodepool.DefaultSlabSize
+
+// Pool - allocator of Node's, that takes them from slabs,
+// allocated by one heap allocation, and reuses removed ones.
+// Pool can be shared by Lists, but is not safe for concurrent use.
+//
+// Node's removed from List with Pool are reused,
+// so references to them must not be kept after removal.
+type Pool[T any] struct {
+	nodes *nodepool.Pool[Node[T]]
+}
+
+// NewPool - returns new Pool, that allocates slabs of provided size.
+// If size is not positive, DefaultSlabSize is used.
+func NewPool[T any](slabSize int) *Pool[T] {
+	return &Pool[T]{nodes: nodepool.New[Node[T]](slabSize)}
+}
+
+// Option - configures List, provided in New.
+type Option[T any] func(*List[T])
+
+// WithPool - makes List allocate Node's from p, and return removed ones to it.
+func WithPool[T any](p *Pool[T]) Option[T] {
+	return func(l *List[T]) {
+		l.pool = p
+	}
+}
+
+// newNode - returns new Node with provided value,
+// taken from Pool if List has one.
+func (l *List[T]) newNode(v T) *Node[T] {
+	if l.pool != nil {
+		n := l.pool.nodes.Get()
+		n.Value = v
+		return n
+	}
+	return &Node[T]{Value: v}
+}
+
+// release - returns removed n to Pool, if List has one.
+// Pool zeroes n, so it keeps no links to List.
+func (l *List[T]) release(n *Node[T]) {
+	if l.pool != nil {
+		l.pool.nodes.Put(n)
+	}
+}
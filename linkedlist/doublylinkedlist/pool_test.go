@@ -0,0 +1,90 @@
+package doublylinkedlist
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool[string](4)
+	l := New(WithPool(pool))
+	for _, v := range []string{"a", "b", "c", "d"} {
+		l.Append(v)
+	}
+
+	// removed from the middle, the Node had both links
+	mid := l.NodeAt(1)
+	l.Remove(mid)
+	if *mid != (Node[string]{}) {
+		t.Fatalf("Expected zeroed released Node\nGot: %+v", *mid)
+	}
+
+	// reused at the back, Prev must point to the new neighbour only
+	l.Append("e")
+	if l.Tail != mid || mid.Prev.Value != "d" || mid.Next != nil {
+		t.Fatalf("Expected released Node reused as Tail\nGot: %v", mid)
+	}
+	checkLinks(t, l)
+
+	head := l.Head
+	l.RemoveIf(func(v string) bool { return v == "a" })
+	if head.Prev != nil || head.Next != nil || head.owner != nil || head.Value != "" {
+		t.Fatalf("Expected zeroed removed Head\nGot: %+v", *head)
+	}
+
+	l.Clear()
+	if pool.nodes.Free() != 4 || mid.Prev != nil {
+		t.Fatalf("Expected free zeroed Node's: 4\nGot: %d", pool.nodes.Free())
+	}
+
+	other := New(WithPool(pool))
+	other.Append("x")
+	other.Prepend("w")
+	if exp := []string{"w", "x"}; !reflect.DeepEqual(other.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, other.ToSlice())
+	}
+	checkLinks(t, other)
+
+	sub := other.Sublist(0, 2)
+	if sub.pool != pool || pool.nodes.Free() != 0 {
+		t.Fatalf("Expected Sublist to take Node's from shared Pool")
+	}
+}
+
+func BenchmarkAppendPooled(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("input %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				list := New(WithPool(NewPool[int](DefaultSlabSize)))
+				for v := 0; v < n; v++ {
+					list.Append(v)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+	}
+}
+
+func BenchmarkChurn(b *testing.B) {
+	for name, opts := range map[string][]Option[int]{
+		"heap": nil,
+		"pool": {WithPool(NewPool[int](DefaultSlabSize))},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			list := New(opts...)
+			for v := 0; v < 1_000; v++ {
+				list.Append(v)
+			}
+
+			for i := 0; i < b.N; i++ {
+				list.Remove(list.Head)
+				list.Append(i)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package doublylinkedlist
+
+import (
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Collection[any] = (*Ring[any])(nil)
+
+// Ring - represents a circular doubly-linked list,
+// Next of the last Node is the first one, and Prev of the first is the last.
+// Ring keeps current Node, which is the first one for Push, Unlink and iteration.
+// Ring with capacity works as ring buffer: when it is full,
+// Push overwrites the oldest value.
+// Node's of Ring are RingNode's, which are linked by Ring methods only.
+type Ring[T any] struct {
+	// head - current Node, nil if Ring is empty.
+	head     *RingNode[T]
+	length   int
+	capacity int
+}
+
+// RingNode - represents a Node of Ring, that holds values of any type.
+// Unlike Node, its links can't be changed outside of Ring,
+// so Ring Len is always exact.
+type RingNode[T any] struct {
+	Value      T
+	prev, next *RingNode[T]
+}
+
+// Next - returns the next Node of Ring.
+func (n *RingNode[T]) Next() *RingNode[T] {
+	return n.next
+}
+
+// Prev - returns the previous Node of Ring.
+func (n *RingNode[T]) Prev() *RingNode[T] {
+	return n.prev
+}
+
+// NewRing - returns new empty Ring, with provided capacity,
+// if capacity is not positive, Ring is unlimited.
+func NewRing[T any](capacity int) *Ring[T] {
+	return &Ring[T]{capacity: max(capacity, 0)}
+}
+
+// Current - returns current Node of Ring, or nil if Ring is empty.
+func (r *Ring[T]) Current() *RingNode[T] {
+	return r.head
+}
+
+// Value - returns value of current Node,
+// if Ring is empty, returns zero value and false.
+func (r *Ring[T]) Value() (T, bool) {
+	if r.head == nil {
+		var zero T
+		return zero, false
+	}
+	return r.head.Value, true
+}
+
+// Len - returns count of Ring Node's.
+func (r *Ring[T]) Len() int {
+	return r.length
+}
+
+// Capacity - returns max count of Ring Node's, 0 for unlimited Ring.
+func (r *Ring[T]) Capacity() int {
+	return r.capacity
+}
+
+// IsEmpty - returns true, if Ring has no Node's.
+func (r *Ring[T]) IsEmpty() bool {
+	return r.length == 0
+}
+
+// IsFull - returns true, if Ring has capacity and it is reached.
+func (r *Ring[T]) IsFull() bool {
+	return r.capacity > 0 && r.length == r.capacity
+}
+
+// Clear - removes all Node's from Ring.
+func (r *Ring[T]) Clear() {
+	r.head, r.length = nil, 0
+}
+
+// Push - adds value before current Node, as the last one of Ring, returns its Node.
+// If Ring is full, value of current Node, which is the oldest, is overwritten
+// and the next Node becomes current.
+func (r *Ring[T]) Push(v T) *RingNode[T] {
+	if r.head == nil {
+		r.head = &RingNode[T]{Value: v}
+		r.head.prev, r.head.next = r.head, r.head
+		r.length = 1
+		return r.head
+	}
+
+	if r.IsFull() {
+		n := r.head
+		n.Value, r.head = v, n.next
+		return n
+	}
+
+	last := r.head.prev
+	n := &RingNode[T]{Value: v, prev: last, next: r.head}
+	last.next, r.head.prev = n, n
+	r.length++
+	return n
+}
+
+// Add - pushes value to Ring, implements containers.Collection.
+func (r *Ring[T]) Add(v T) bool {
+	r.Push(v)
+	return true
+}
+
+// Move - returns Node n steps forward from current, or backward if n < 0,
+// or nil if Ring is empty. Current Node is not changed.
+// Takes O(min(n mod Len, Len - n mod Len)) steps.
+func (r *Ring[T]) Move(n int) *RingNode[T] {
+	if r.head == nil {
+		return nil
+	}
+	return move(r.head, n, r.length)
+}
+
+// Rotate - makes Node n steps forward from current the current one,
+// or backward if n < 0.
+func (r *Ring[T]) Rotate(n int) {
+	r.head = r.Move(n)
+}
+
+// Unlink - removes n Node's starting from current, returns them as new
+// unlimited Ring. The Node after removed ones becomes current.
+// If n is greater than Len, all Node's are removed.
+func (r *Ring[T]) Unlink(n int) *Ring[T] {
+	removed := NewRing[T](0)
+	if n <= 0 || r.head == nil {
+		return removed
+	}
+	if n >= r.length {
+		removed.head, removed.length = r.head, r.length
+		r.Clear()
+		return removed
+	}
+
+	first, last := r.head, move(r.head, n-1, r.length)
+	prev, next := first.prev, last.next
+
+	prev.next, next.prev = next, prev
+	first.prev, last.next = last, first
+
+	removed.head, removed.length = first, n
+	r.head, r.length = next, r.length-n
+	return removed
+}
+
+// Do - calls f for each value of Ring, starting from current Node.
+// f must not modify Ring.
+func (r *Ring[T]) Do(f func(T)) {
+	for v := range r.Values() {
+		f(v)
+	}
+}
+
+// ToSlice - returns values of all Node's, one round forward
+// starting from current one, so Rotate changes the order.
+func (r *Ring[T]) ToSlice() []T {
+	values := make([]T, 0, r.length)
+	for v := range r.Values() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// All - returns iterator over values of Ring Node's with their offsets
+// from current Node, for one round forward.
+// Ring must not be modified during iteration.
+func (r *Ring[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ptr := r.head
+		for i := 0; i < r.length; i++ {
+			if !yield(i, ptr.Value) {
+				return
+			}
+			ptr = ptr.next
+		}
+	}
+}
+
+// Backward - returns iterator over values of Ring Node's with their offsets
+// from current Node, for one round backward, starting from current Node.
+// Ring must not be modified during iteration.
+func (r *Ring[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		ptr := r.head
+		for i := 0; i < r.length; i++ {
+			if !yield(-i, ptr.Value) {
+				return
+			}
+			ptr = ptr.prev
+		}
+	}
+}
+
+// Values - returns iterator over values of Ring Node's,
+// for one round forward from current Node.
+func (r *Ring[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range r.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor - returns Cursor, positioned at current Node of Ring.
+func (r *Ring[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{ring: r, node: r.head}
+}
+
+// Cursor - traverses Ring forward and backward endlessly.
+// Cursor is independent of current Node of Ring.
+// Node under Cursor must not be unlinked, while Cursor is used.
+type Cursor[T any] struct {
+	ring *Ring[T]
+	node *RingNode[T]
+}
+
+// Node - returns Node under Cursor, or nil if Ring was empty.
+func (c *Cursor[T]) Node() *RingNode[T] {
+	return c.node
+}
+
+// Value - returns value of Node under Cursor.
+func (c *Cursor[T]) Value() T {
+	return c.node.Value
+}
+
+// Next - moves Cursor forward, returns false if Ring was empty.
+func (c *Cursor[T]) Next() bool {
+	return c.Move(1)
+}
+
+// Prev - moves Cursor backward, returns false if Ring was empty.
+func (c *Cursor[T]) Prev() bool {
+	return c.Move(-1)
+}
+
+// Move - moves Cursor n steps forward, or backward if n < 0,
+// returns false if Ring was empty or became empty.
+// Takes O(min(n mod Len, Len - n mod Len)) steps.
+func (c *Cursor[T]) Move(n int) bool {
+	if c.node == nil || c.ring.length == 0 {
+		return false
+	}
+	c.node = move(c.node, n, c.ring.length)
+	return true
+}
+
+// move - returns Node n steps forward from ptr, or backward if n < 0,
+// in Ring of provided length, going in the shorter direction.
+func move[T any](ptr *RingNode[T], n, length int) *RingNode[T] {
+	n %= length
+	if n > length/2 {
+		n -= length
+	} else if n < -length/2 {
+		n += length
+	}
+
+	for ; n > 0; n-- {
+		ptr = ptr.next
+	}
+	for ; n < 0; n++ {
+		ptr = ptr.prev
+	}
+	return ptr
+}
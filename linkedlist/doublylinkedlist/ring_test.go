@@ -0,0 +1,202 @@
+package doublylinkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkRing - checks, that Ring is closed in both directions with Len Node's.
+func checkRing[T any](t *testing.T, r *Ring[T]) {
+	t.Helper()
+
+	if r.Current() == nil {
+		if r.Len() != 0 {
+			t.Fatalf("Expected length of empty Ring: 0\nGot: %d", r.Len())
+		}
+		return
+	}
+
+	count, ptr := 0, r.Current()
+	for {
+		if ptr.next.prev != ptr {
+			t.Fatalf("Expected Prev of Next to be Node: %v", ptr)
+		}
+		count, ptr = count+1, ptr.next
+		if ptr == r.Current() || count > r.Len() {
+			break
+		}
+	}
+
+	if count != r.Len() {
+		t.Fatalf("Expected length: %d\nGot: %d", count, r.Len())
+	}
+}
+
+func TestRing(t *testing.T) {
+	t.Parallel()
+
+	r := NewRing[int](0)
+	if _, ok := r.Value(); ok || r.Move(3) != nil {
+		t.Fatalf("Expected empty Ring")
+	}
+
+	for v := range 5 {
+		r.Push(v)
+	}
+	checkRing(t, r)
+
+	r.Rotate(2)
+	if exp := []int{2, 3, 4, 0, 1}; !reflect.DeepEqual(r.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, r.ToSlice())
+	}
+
+	r.Rotate(-3)
+	if v, _ := r.Value(); v != 4 {
+		t.Fatalf("Expected current: 4\nGot: %d", v)
+	}
+	if n := r.Move(12); n.Value != 1 {
+		t.Fatalf("Expected Node 12 steps forward: 1\nGot: %d", n.Value)
+	}
+
+	var backward []int
+	for _, v := range r.Backward() {
+		backward = append(backward, v)
+	}
+	if exp := []int{4, 3, 2, 1, 0}; !reflect.DeepEqual(backward, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, backward)
+	}
+
+	sum := 0
+	r.Do(func(v int) { sum += v })
+	if sum != 10 {
+		t.Fatalf("Expected sum: 10\nGot: %d", sum)
+	}
+}
+
+func TestRingUnlink(t *testing.T) {
+	t.Parallel()
+
+	r := NewRing[int](0)
+	for v := range 6 {
+		r.Push(v)
+	}
+
+	removed := r.Unlink(2)
+	if exp := []int{0, 1}; !reflect.DeepEqual(removed.ToSlice(), exp) {
+		t.Fatalf("Expected removed: %v\nGot: %v", exp, removed.ToSlice())
+	}
+	if exp := []int{2, 3, 4, 5}; !reflect.DeepEqual(r.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, r.ToSlice())
+	}
+	checkRing(t, r)
+	checkRing(t, removed)
+
+	if r.Unlink(0).Len() != 0 {
+		t.Fatalf("Expected nothing to be unlinked")
+	}
+
+	all := r.Unlink(10)
+	if all.Len() != 4 || !r.IsEmpty() || r.Current() != nil {
+		t.Fatalf("Expected all Node's to be unlinked\nGot: %d, %d", all.Len(), r.Len())
+	}
+	checkRing(t, all)
+}
+
+func TestRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	r := NewRing[int](3)
+	for v := range 5 {
+		r.Add(v)
+	}
+	checkRing(t, r)
+
+	if !r.IsFull() || r.Capacity() != 3 {
+		t.Fatalf("Expected full Ring with capacity: 3\nGot: %d, %d", r.Len(), r.Capacity())
+	}
+	if exp := []int{2, 3, 4}; !reflect.DeepEqual(slices.Collect(r.Values()), exp) {
+		t.Fatalf("Expected the newest values: %v\nGot: %v", exp, r.ToSlice())
+	}
+
+	r.Unlink(1)
+	r.Push(5)
+	if exp := []int{3, 4, 5}; !reflect.DeepEqual(r.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, r.ToSlice())
+	}
+
+	r.Clear()
+	if !r.IsEmpty() || r.IsFull() {
+		t.Fatalf("Expected empty Ring after Clear")
+	}
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	empty := NewRing[string](0).Cursor()
+	if empty.Next() || empty.Prev() || empty.Node() != nil {
+		t.Fatalf("Expected Cursor of empty Ring not to move")
+	}
+
+	r := NewRing[string](0)
+	for _, v := range []string{"a", "b", "c"} {
+		r.Push(v)
+	}
+
+	c := r.Cursor()
+	var got []string
+	for i := 0; i < 7; i++ {
+		got = append(got, c.Value())
+		c.Next()
+	}
+	if exp := []string{"a", "b", "c", "a", "b", "c", "a"}; !reflect.DeepEqual(got, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, got)
+	}
+
+	c.Prev()
+	c.Move(-4)
+	if c.Value() != "c" {
+		t.Fatalf("Expected: c\nGot: %s", c.Value())
+	}
+	if v, _ := r.Value(); v != "a" {
+		t.Fatalf("Expected Cursor not to change current Node: a\nGot: %s", v)
+	}
+}
+
+func TestRingMove(t *testing.T) {
+	t.Parallel()
+
+	for length := 1; length <= 6; length++ {
+		r := NewRing[int](0)
+		for v := range length {
+			r.Push(v)
+		}
+
+		c := r.Cursor()
+		for n := -2 * length; n <= 2*length; n++ {
+			exp := ((c.Value()+n)%length + length) % length
+			if got := r.Move(n).Value; got != ((n%length)+length)%length {
+				t.Fatalf("Expected Node %d steps from current of %d: %d\nGot: %d", n, length, ((n%length)+length)%length, got)
+			}
+			if c.Move(n); c.Value() != exp {
+				t.Fatalf("Expected Cursor %d steps of %d: %d\nGot: %d", n, length, exp, c.Value())
+			}
+		}
+	}
+
+	// reduced by Len, not stepped one by one
+	r := NewRing[string](0)
+	r.Push("a")
+	r.Push("b")
+	r.Push("c")
+	c := r.Cursor()
+	if !c.Move(1_000_000_000_000_000_001) || c.Value() != "c" || r.Move(-1_000_000_000_000_000_000).Value != "c" {
+		t.Fatalf("Expected huge moves reduced by Len: c\nGot: %s", c.Value())
+	}
+
+	r.Clear()
+	if c.Move(1) {
+		t.Fatalf("Expected Cursor of cleared Ring not to move")
+	}
+}
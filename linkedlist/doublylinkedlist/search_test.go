@@ -0,0 +1,141 @@
+package doublylinkedlist
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3, 4)
+	even := func(v int) bool { return v%2 == 0 }
+
+	n := list.Find(even)
+	if n == nil || n.Value != 2 || n.Prev != list.Head || n.Next.Next != list.Tail {
+		t.Fatalf("Expected linked node: 2\nGot: %v", n)
+	}
+	if i := list.IndexFunc(even); i != 1 || IndexOf(list, 4) != 3 || IndexOf(list, 5) != -1 {
+		t.Fatalf("Expected index: 1\nGot: %d", i)
+	}
+	if !Contains(list, 3) || Contains(list, 0) || !list.ContainsFunc(even) {
+		t.Fatal("Expected contains 3, not 0 and even value")
+	}
+}
+
+func TestNodeAt(t *testing.T) {
+	t.Parallel()
+
+	// even and odd lengths put the midpoint on both sides of Length/2
+	for _, length := range []int{1, 2, 5, 6} {
+		list := New[int]()
+		for i := range length {
+			list.Append(i)
+		}
+
+		// cut the forward links of the second half,
+		// so indexes at or after Length/2 are reachable only by Prev from Tail
+		var forward []*Node[int]
+		for ptr := list.Head; ptr != nil; ptr = ptr.Next {
+			forward = append(forward, ptr)
+		}
+		if length/2 > 0 {
+			forward[length/2-1].Next = nil
+		}
+
+		for i := range length {
+			if n := list.NodeAt(i); n != forward[i] {
+				t.Fatalf("Expected node at %d of %d: %v\nGot: %v", i, length, forward[i], n)
+			}
+		}
+
+		for _, i := range []int{-1, length} {
+			if _, ok := list.At(i); ok || list.NodeAt(i) != nil {
+				t.Fatalf("Expected out of range at: %d", i)
+			}
+		}
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	t.Parallel()
+
+	list := From(0, 1, 2, 3, 4, 5)
+	model := list.ToSlice()
+
+	// indexes before and after midpoint, then both ends
+	for _, i := range []int{1, 5, 3, 4, 0, 11} {
+		n := list.InsertAt(i, 10+i)
+		model = slices.Insert(model, i, 10+i)
+
+		if n.Prev != list.NodeAt(i-1) || n.Next != list.NodeAt(i+1) {
+			t.Fatalf("Expected node at %d linked to neighbours", i)
+		}
+		checkLinks(t, list)
+	}
+
+	if list.InsertAt(list.Length+1, 0) != nil || list.InsertAt(-1, 0) != nil {
+		t.Fatal("Expected nil insert out of range")
+	}
+
+	if got := list.ToSlice(); !reflect.DeepEqual(model, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", model, got)
+	}
+
+	backward := make([]int, list.Length)
+	for i, v := range list.Backward() {
+		backward[i] = v
+	}
+	if !reflect.DeepEqual(model, backward) {
+		t.Fatalf("Expected backward values: %v\nGot: %v", model, backward)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	t.Parallel()
+
+	// removes Head, Tail and Node's around midpoint
+	list := From(0, 1, 2, 3, 4, 5, 6, 7)
+	if n := list.RemoveIf(func(v int) bool { return v == 0 || v == 3 || v == 4 || v == 7 }); n != 4 {
+		t.Fatalf("Expected removed: 4\nGot: %d", n)
+	}
+	checkLinks(t, list)
+
+	exp := []int{1, 2, 5, 6}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	if list.Head.Prev != nil || list.Tail.Value != 6 || list.Tail.Prev.Value != 5 || list.NodeAt(2).Prev.Value != 2 {
+		t.Fatalf("Expected Prev links of values: %v", exp)
+	}
+
+	list.RemoveIf(func(int) bool { return true })
+	checkLinks(t, list)
+	if list.Head != nil || list.Tail != nil {
+		t.Fatal("Expected empty list\nGot: ", list)
+	}
+}
+
+func TestSublist(t *testing.T) {
+	t.Parallel()
+
+	list := From(0, 1, 2, 3, 4, 5)
+
+	// from the first half, and from the second one, reached by Prev
+	for _, r := range [][2]int{{1, 4}, {4, 6}} {
+		sub := list.Sublist(r[0], r[1])
+		checkLinks(t, sub)
+
+		if exp := list.ToSlice()[r[0]:r[1]]; !reflect.DeepEqual(exp, sub.ToSlice()) {
+			t.Fatalf("Expected values: %v\nGot: %v", exp, sub.ToSlice())
+		}
+	}
+
+	if sub := list.Sublist(2, 2); sub == nil || sub.Length != 0 {
+		t.Fatal("Expected empty sublist\nGot: ", sub)
+	}
+	if list.Sublist(3, 2) != nil || list.Sublist(0, 7) != nil {
+		t.Fatal("Expected nil sublist of invalid range")
+	}
+}
@@ -0,0 +1,274 @@
+// Package indexlist - doubly-linked list, stored in one slice.
+package indexlist
+
+import (
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Sequence[any] = (*List[any])(nil)
+
+// Nil - index of no element, returned when there is no such element.
+const Nil = 0
+
+// List - represents a doubly-linked list, which elements are stored
+// in one slice and linked by indexes instead of pointers.
+// Elements are addressed by indexes, that stay valid until removal.
+// Removed slots are reused, so List grows only if it has no free slots,
+// and GC never scans its links.
+type List[T any] struct {
+	// nodes - slots of elements, nodes[Nil] is a sentinel,
+	// which next is Head and prev is Tail.
+	nodes []node[T]
+	// free - first removed slot, free slots are linked by next.
+	free   int
+	length int
+}
+
+// node - slot of List element.
+type node[T any] struct {
+	value      T
+	prev, next int
+	// used - true, if slot holds an element.
+	used bool
+}
+
+// New - returns new empty List.
+// Zero value of List is an empty List too.
+func New[T any]() *List[T] {
+	return &List[T]{}
+}
+
+// From - returns List of provided values, from Head to Tail.
+func From[T any](values ...T) *List[T] {
+	l := New[T]()
+	l.Grow(len(values))
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// init - creates sentinel slot on first use.
+func (l *List[T]) init() {
+	if l.nodes == nil {
+		l.nodes = make([]node[T], 1)
+	}
+}
+
+// Grow - reserves space for n more elements without reallocation.
+func (l *List[T]) Grow(n int) {
+	l.init()
+	if free := cap(l.nodes) - len(l.nodes); n > free {
+		nodes := make([]node[T], len(l.nodes), len(l.nodes)+n)
+		copy(nodes, l.nodes)
+		l.nodes = nodes
+	}
+}
+
+// valid - returns true, if i is index of List element.
+func (l *List[T]) valid(i int) bool {
+	return i > Nil && i < len(l.nodes) && l.nodes[i].used
+}
+
+// insert - adds value after element at index at, returns its index.
+func (l *List[T]) insert(v T, at int) int {
+	l.init()
+
+	i := l.free
+	if i != Nil {
+		l.free = l.nodes[i].next
+	} else {
+		i = len(l.nodes)
+		l.nodes = append(l.nodes, node[T]{})
+	}
+
+	next := l.nodes[at].next
+	l.nodes[i] = node[T]{value: v, prev: at, next: next, used: true}
+	l.nodes[at].next = i
+	l.nodes[next].prev = i
+
+	l.length++
+	return i
+}
+
+// PushBack - adds new Tail to List in O(1), returns its index.
+func (l *List[T]) PushBack(v T) int {
+	l.init()
+	return l.insert(v, l.nodes[Nil].prev)
+}
+
+// PushFront - adds new Head to List in O(1), returns its index.
+func (l *List[T]) PushFront(v T) int {
+	return l.insert(v, Nil)
+}
+
+// InsertAfter - adds v after element at index mark, returns its index.
+// If mark is not an element of List, List is not modified and Nil is returned.
+func (l *List[T]) InsertAfter(v T, mark int) int {
+	if !l.valid(mark) {
+		return Nil
+	}
+	return l.insert(v, mark)
+}
+
+// InsertBefore - adds v before element at index mark, returns its index.
+// If mark is not an element of List, List is not modified and Nil is returned.
+func (l *List[T]) InsertBefore(v T, mark int) int {
+	if !l.valid(mark) {
+		return Nil
+	}
+	return l.insert(v, l.nodes[mark].prev)
+}
+
+// Remove - removes element at index i, returns its value and true.
+// If i is not an element of List, returns zero value and false.
+func (l *List[T]) Remove(i int) (T, bool) {
+	if !l.valid(i) {
+		var zero T
+		return zero, false
+	}
+
+	n := l.nodes[i]
+	l.nodes[n.prev].next = n.next
+	l.nodes[n.next].prev = n.prev
+
+	l.nodes[i] = node[T]{next: l.free}
+	l.free = i
+	l.length--
+	return n.value, true
+}
+
+// Get - returns value of element at index i and true,
+// if i is not an element of List, returns zero value and false.
+func (l *List[T]) Get(i int) (T, bool) {
+	if !l.valid(i) {
+		var zero T
+		return zero, false
+	}
+	return l.nodes[i].value, true
+}
+
+// Set - replaces value of element at index i, returns false
+// if i is not an element of List.
+func (l *List[T]) Set(i int, v T) bool {
+	if !l.valid(i) {
+		return false
+	}
+	l.nodes[i].value = v
+	return true
+}
+
+// Head - returns index of the first element, or Nil if List is empty.
+func (l *List[T]) Head() int {
+	if l.nodes == nil {
+		return Nil
+	}
+	return l.nodes[Nil].next
+}
+
+// Tail - returns index of the last element, or Nil if List is empty.
+func (l *List[T]) Tail() int {
+	if l.nodes == nil {
+		return Nil
+	}
+	return l.nodes[Nil].prev
+}
+
+// Next - returns index of element after i, or Nil if there is no such.
+func (l *List[T]) Next(i int) int {
+	if !l.valid(i) {
+		return Nil
+	}
+	return l.nodes[i].next
+}
+
+// Prev - returns index of element before i, or Nil if there is no such.
+func (l *List[T]) Prev(i int) int {
+	if !l.valid(i) {
+		return Nil
+	}
+	return l.nodes[i].prev
+}
+
+// Add - appends value to List, implements containers.Collection.
+func (l *List[T]) Add(v T) bool {
+	l.PushBack(v)
+	return true
+}
+
+// Len - returns count of List elements.
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+// IsEmpty - returns true, if List has no elements.
+func (l *List[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
+// Clear - removes all elements from List, keeping allocated space.
+func (l *List[T]) Clear() {
+	if l.nodes != nil {
+		clear(l.nodes)
+		l.nodes = l.nodes[:1]
+	}
+	l.free, l.length = Nil, 0
+}
+
+// Front - returns Head value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Front() (T, bool) {
+	return l.Get(l.Head())
+}
+
+// Back - returns Tail value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Back() (T, bool) {
+	return l.Get(l.Tail())
+}
+
+// ToSlice - returns values of all elements, from Head to Tail.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.length)
+	for v := range l.Values() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// All - returns iterator over indexes of elements with their values,
+// from Head to Tail.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := l.Head(); i != Nil; i = l.nodes[i].next {
+			if !yield(i, l.nodes[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns iterator over indexes of elements with their values,
+// from Tail to Head.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := l.Tail(); i != Nil; i = l.nodes[i].prev {
+			if !yield(i, l.nodes[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Values - returns iterator over values of elements, from Head to Tail.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package indexlist
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/doublylinkedlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/singlylinkedlist"
+)
+
+// checkLinks - checks, that prev and next links agree, and Length is right.
+func checkLinks[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+
+	count, prev := 0, Nil
+	for i := l.Head(); i != Nil; i = l.Next(i) {
+		if l.Prev(i) != prev {
+			t.Fatalf("Expected prev of %d: %d\nGot: %d", i, prev, l.Prev(i))
+		}
+		count, prev = count+1, i
+	}
+
+	if count != l.Len() || prev != l.Tail() {
+		t.Fatalf("Expected length: %d, tail: %d\nGot: %d, %d", count, prev, l.Len(), l.Tail())
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	var l List[string]
+	if _, ok := l.Front(); ok || l.Head() != Nil || l.Tail() != Nil {
+		t.Fatalf("Expected zero value to be an empty List")
+	}
+
+	b := l.PushBack("b")
+	a := l.PushFront("a")
+	d := l.PushBack("d")
+	c := l.InsertBefore("c", d)
+	l.InsertAfter("e", d)
+	checkLinks(t, &l)
+
+	if exp := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(l.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, l.ToSlice())
+	}
+
+	if v, ok := l.Remove(c); !ok || v != "c" {
+		t.Fatalf("Expected removed: c, true\nGot: %s, %v", v, ok)
+	}
+	if _, ok := l.Remove(c); ok {
+		t.Fatalf("Expected second Remove of %d to fail", c)
+	}
+	if l.InsertAfter("x", c) != Nil || l.Set(c, "x") {
+		t.Fatalf("Expected removed index %d to be invalid", c)
+	}
+
+	if reused := l.PushFront("z"); reused != c {
+		t.Fatalf("Expected removed slot to be reused: %d\nGot: %d", c, reused)
+	}
+	l.Set(a, "A")
+	if v, _ := l.Get(b); v != "b" {
+		t.Fatalf("Expected value: b\nGot: %s", v)
+	}
+	checkLinks(t, &l)
+
+	var backward []string
+	for _, v := range l.Backward() {
+		backward = append(backward, v)
+	}
+	if exp := []string{"e", "d", "b", "A", "z"}; !reflect.DeepEqual(backward, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, backward)
+	}
+
+	l.Clear()
+	if !l.IsEmpty() || l.Head() != Nil {
+		t.Fatalf("Expected empty List after Clear")
+	}
+	if i := l.PushBack("new"); i != 1 {
+		t.Fatalf("Expected index after Clear: 1\nGot: %d", i)
+	}
+}
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	l := From(1, 2, 3)
+	if got := slices.Collect(l.Values()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("Expected: %v\nGot: %v", []int{1, 2, 3}, got)
+	}
+	if v, ok := l.Back(); !ok || v != 3 {
+		t.Fatalf("Expected back: 3, true\nGot: %d, %v", v, ok)
+	}
+	checkLinks(t, l)
+}
+
+// Allocations per appended element of pointer and index based lists.
+func BenchmarkAppend(b *testing.B) {
+	const n = 10_000
+
+	b.Run(fmt.Sprintf("singlylinkedlist %d", n), func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := singlylinkedlist.New[int]()
+			for v := 0; v < n; v++ {
+				l.Append(v)
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("doublylinkedlist %d", n), func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := doublylinkedlist.New[int]()
+			for v := 0; v < n; v++ {
+				l.Append(v)
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("doublylinkedlist pooled %d", n), func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := doublylinkedlist.New(doublylinkedlist.WithPool(doublylinkedlist.NewPool[int](0)))
+			for v := 0; v < n; v++ {
+				l.Append(v)
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("indexlist %d", n), func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := New[int]()
+			for v := 0; v < n; v++ {
+				l.PushBack(v)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package singlylinkedlist
+
+// Reverse - reverses order of List Node's in place in O(n).
+func (l *List[T]) Reverse() {
+	var prev *Node[T]
+	l.Tail = l.Head
+
+	for ptr := l.Head; ptr != nil; {
+		next := ptr.Next
+		ptr.Next = prev
+		prev, ptr = ptr, next
+	}
+	l.Head = prev
+}
+
+// MergeSort - sorts List in place in O(n*log(n)), keeping order of equal values.
+// compare returns negative number if a < b, positive if a > b,
+// and zero if they are equal.
+func (l *List[T]) MergeSort(compare func(a, b T) int) {
+	l.Head = mergeSort(l.Head, l.Length, compare)
+
+	l.Tail = l.Head
+	for l.Tail != nil && l.Tail.Next != nil {
+		l.Tail = l.Tail.Next
+	}
+}
+
+// Merge - merges sorted other List into sorted List in O(n+m),
+// on equal values List Node's go first. other becomes empty.
+func (l *List[T]) Merge(other *List[T], compare func(a, b T) int) {
+	if other == l || other.Head == nil {
+		return
+	}
+
+	other.own.Forward(l.owner())
+
+	tail := other.Tail
+	if l.Tail != nil && compare(l.Tail.Value, other.Tail.Value) > 0 {
+		tail = l.Tail
+	}
+
+	l.Head = merge(l.Head, other.Head, compare)
+	l.Tail = tail
+	l.Length += other.Length
+
+	other.Head, other.Tail, other.Length = nil, nil, 0
+}
+
+// Concat - moves all Node's of other List to the back of List in O(1).
+// other becomes empty.
+func (l *List[T]) Concat(other *List[T]) {
+	l.Splice(other, l.Tail)
+}
+
+// Splice - moves all Node's of other List after mark in O(1),
+// or to the front of List, if mark is nil. other becomes empty.
+// Returns false, if mark is not an element of List, or other is List itself.
+func (l *List[T]) Splice(other *List[T], mark *Node[T]) bool {
+	if other == l || mark != nil && !l.owns(mark) {
+		return false
+	}
+	if other.Head == nil {
+		return true
+	}
+
+	first, last := other.Head, other.Tail
+	if mark == nil {
+		last.Next, l.Head = l.Head, first
+	} else {
+		last.Next, mark.Next = mark.Next, first
+	}
+
+	if last.Next == nil {
+		l.Tail = last
+	}
+
+	other.own.Forward(l.owner())
+	l.Length += other.Length
+
+	other.Head, other.Tail, other.Length = nil, nil, 0
+	return true
+}
+
+// Split - cuts List at index i, where 0 <= i <= Length,
+// List keeps Node's before i, the rest are returned as new List.
+// Returns nil if i is out of range.
+func (l *List[T]) Split(i int) *List[T] {
+	if i < 0 || i > l.Length {
+		return nil
+	}
+
+	rest := New(WithPool(l.pool))
+	prev := l.NodeAt(i - 1)
+
+	if prev == nil {
+		rest.Head, l.Head = l.Head, nil
+	} else {
+		rest.Head, prev.Next = prev.Next, nil
+	}
+
+	if rest.Head != nil {
+		rest.Tail = l.Tail
+	}
+	rest.Length = l.Length - i
+	l.Tail, l.Length = prev, i
+
+	for ptr := rest.Head; ptr != nil; ptr = ptr.Next {
+		ptr.owner = rest.owner()
+	}
+	return rest
+}
+
+// SplitHalves - cuts List into halves, List keeps the first one,
+// that is longer by one Node for odd Length. Returns the second half.
+func (l *List[T]) SplitHalves() *List[T] {
+	return l.Split((l.Length + 1) / 2)
+}
+
+// HasCycle - returns true, if Node's, linked from Head, form a cycle.
+// Cycle can be made only by direct modification of Next field.
+func (l *List[T]) HasCycle() bool {
+	return l.CycleStart() != nil
+}
+
+// CycleStart - returns the first Node of cycle, using Floyd's algorithm
+// in O(n) time and O(1) memory, or nil if there is no cycle.
+func (l *List[T]) CycleStart() *Node[T] {
+	slow, fast := l.Head, l.Head
+	for fast != nil && fast.Next != nil {
+		slow, fast = slow.Next, fast.Next.Next
+		if slow == fast {
+			for slow = l.Head; slow != fast; {
+				slow, fast = slow.Next, fast.Next
+			}
+			return slow
+		}
+	}
+	return nil
+}
+
+// mergeSort - sorts n Node's, starting from head, where the last one
+// has no Next Node. Returns new head.
+func mergeSort[T any](head *Node[T], n int, compare func(a, b T) int) *Node[T] {
+	if n < 2 {
+		return head
+	}
+
+	mid := head
+	for i := 1; i < n/2; i++ {
+		mid = mid.Next
+	}
+	right := mid.Next
+	mid.Next = nil
+
+	return merge(
+		mergeSort(head, n/2, compare),
+		mergeSort(right, n-n/2, compare),
+		compare,
+	)
+}
+
+// merge - merges two sorted chains of Node's, on equal values
+// Node's of a go first. Returns new head.
+func merge[T any](a, b *Node[T], compare func(a, b T) int) *Node[T] {
+	var head Node[T]
+	tail := &head
+
+	for a != nil && b != nil {
+		if compare(a.Value, b.Value) <= 0 {
+			tail.Next, a = a, a.Next
+		} else {
+			tail.Next, b = b, b.Next
+		}
+		tail = tail.Next
+	}
+
+	if a != nil {
+		tail.Next = a
+	} else {
+		tail.Next = b
+	}
+	return head.Next
+}
@@ -0,0 +1,58 @@
+package singlylinkedlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3)
+	formats := []struct{ got, exp string }{
+		{list.String(), "[1 2 3], Length(3)"},
+		{fmt.Sprintf("%#v", list), "singlylinkedlist.From[int](1, 2, 3)"},
+		{list.Head.Next.String(), "2->3"},
+		{fmt.Sprintf("%02d", list.Head), "01->02"},
+		{list.Tail.String(), "3-><nil>"},
+		{fmt.Sprint((*Node[int])(nil)), "<nil>"},
+	}
+
+	for _, f := range formats {
+		if f.got != f.exp {
+			t.Fatalf("Expected string: %s\nGot: %s", f.exp, f.got)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, list := range []*List[string]{New[string](), From("a", `"b"`)} {
+		var b bytes.Buffer
+		n, err := list.WriteTo(&b)
+
+		data, _ := json.Marshal(list)
+		if err != nil || b.String() != string(data) || n != int64(len(data)) {
+			t.Fatalf("Expected written: %s\nGot: %s, %d, %v", data, b.String(), n, err)
+		}
+	}
+
+	list := From(9)
+	if err := json.Unmarshal([]byte("[1,2,3]"), list); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	exp := []int{1, 2, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+	checkLinks(t, list)
+
+	if err := json.Unmarshal([]byte(`["a"]`), list); err == nil || list.Length != 3 {
+		t.Fatalf("Expected unmarshal error, List kept\nGot: %v, %v", err, list)
+	}
+}
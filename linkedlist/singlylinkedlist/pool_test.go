@@ -0,0 +1,90 @@
+package singlylinkedlist
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool[int](2)
+	l := New(WithPool(pool))
+	for v := range 5 {
+		l.Append(v)
+	}
+	checkLinks(t, l)
+
+	removed := l.Head.Next
+	if v, ok := l.Remove(removed); !ok || v != 1 {
+		t.Fatalf("Expected removed: 1, true\nGot: %d, %v", v, ok)
+	}
+	if *removed != (Node[int]{}) {
+		t.Fatalf("Expected zeroed released Node\nGot: %+v", *removed)
+	}
+	if l.InsertAfter(9, removed) != nil {
+		t.Fatal("Expected released Node not owned by List")
+	}
+
+	front := l.InsertAt(0, 10)
+	if front != removed || front.Next != l.Head.Next {
+		t.Fatalf("Expected removed Node to be reused\nGot: %p, %p", removed, front)
+	}
+	if exp := []int{10, 0, 2, 3, 4}; !reflect.DeepEqual(l.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, l.ToSlice())
+	}
+
+	rest := l.Split(3)
+	if rest.pool != pool {
+		t.Fatalf("Expected Split List to share Pool")
+	}
+
+	tail := l.Tail
+	l.Clear()
+	if pool.nodes.Free() != 3 || tail.Next != nil || tail.owner != nil {
+		t.Fatalf("Expected free zeroed Node's: 3\nGot: %d", pool.nodes.Free())
+	}
+
+	other := New(WithPool(pool))
+	other.Append(7)
+	if exp := []int{7}; !reflect.DeepEqual(other.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, other.ToSlice())
+	}
+	checkLinks(t, other)
+}
+
+func BenchmarkAppendPooled(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("input %d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				list := New(WithPool(NewPool[int](DefaultSlabSize)))
+				for v := 0; v < n; v++ {
+					list.Append(v)
+				}
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*n), "ns/elem")
+		})
+	}
+}
+
+func BenchmarkChurn(b *testing.B) {
+	for name, opts := range map[string][]Option[int]{
+		"heap": nil,
+		"pool": {WithPool(NewPool[int](DefaultSlabSize))},
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			list := New(opts...)
+			for v := 0; v < 1_000; v++ {
+				list.Append(v)
+			}
+
+			for i := 0; i < b.N; i++ {
+				list.Remove(list.Head)
+				list.Append(i)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package singlylinkedlist
+
+// Find - returns the first Node from Head, which value satisfies pred,
+// or nil if there is no such Node.
+func (l *List[T]) Find(pred func(T) bool) *Node[T] {
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		if pred(ptr.Value) {
+			return ptr
+		}
+	}
+	return nil
+}
+
+// IndexFunc - returns index of the first value, that satisfies pred,
+// or -1 if there is no such value.
+func (l *List[T]) IndexFunc(pred func(T) bool) int {
+	i := 0
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		if pred(ptr.Value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// ContainsFunc - returns true, if any value satisfies pred.
+func (l *List[T]) ContainsFunc(pred func(T) bool) bool {
+	return l.Find(pred) != nil
+}
+
+// IndexOf - returns index of the first occurrence of v in List,
+// or -1 if v is not present.
+func IndexOf[T comparable](l *List[T], v T) int {
+	return l.IndexFunc(func(el T) bool { return el == v })
+}
+
+// Contains - returns true, if v is present in List.
+func Contains[T comparable](l *List[T], v T) bool {
+	return IndexOf(l, v) >= 0
+}
+
+// NodeAt - returns Node at index i in O(i),
+// or nil if i is out of range.
+func (l *List[T]) NodeAt(i int) *Node[T] {
+	if i < 0 || i >= l.Length {
+		return nil
+	}
+
+	ptr := l.Head
+	for ; i > 0; i-- {
+		ptr = ptr.Next
+	}
+	return ptr
+}
+
+// At - returns value at index i and true,
+// if i is out of range, returns zero value and false.
+func (l *List[T]) At(i int) (T, bool) {
+	n := l.NodeAt(i)
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.Value, true
+}
+
+// InsertAt - adds new Node with value v at index i, where 0 <= i <= Length,
+// returns new Node or nil if i is out of range.
+func (l *List[T]) InsertAt(i int, v T) *Node[T] {
+	if i < 0 || i > l.Length {
+		return nil
+	}
+	return l.insertNode(l.newNode(v), l.NodeAt(i-1))
+}
+
+// RemoveIf - removes all Node's, which values satisfy pred,
+// returns count of removed Node's.
+func (l *List[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+
+	var prev *Node[T]
+	for ptr := l.Head; ptr != nil; {
+		next := ptr.Next
+		if pred(ptr.Value) {
+			l.unlink(ptr, prev)
+			l.release(ptr)
+			removed++
+		} else {
+			prev = ptr
+		}
+		ptr = next
+	}
+
+	return removed
+}
+
+// Sublist - returns new List with copies of values at indexes [from, to),
+// or nil if range is invalid.
+func (l *List[T]) Sublist(from, to int) *List[T] {
+	if from < 0 || to > l.Length || from > to {
+		return nil
+	}
+
+	sub := New(WithPool(l.pool))
+	for ptr := l.NodeAt(from); from < to; from++ {
+		sub.Append(ptr.Value)
+		ptr = ptr.Next
+	}
+	return sub
+}
@@ -0,0 +1,108 @@
+package singlylinkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3, 4)
+	even := func(v int) bool { return v%2 == 0 }
+
+	if n := list.Find(even); n == nil || n.Value != 2 {
+		t.Fatalf("Expected node: 2\nGot: %v", n)
+	}
+	if n := list.Find(func(v int) bool { return v > 4 }); n != nil {
+		t.Fatalf("Expected node: nil\nGot: %v", n)
+	}
+
+	if i := list.IndexFunc(even); i != 1 {
+		t.Fatalf("Expected index: 1\nGot: %d", i)
+	}
+	if i := IndexOf(list, 4); i != 3 {
+		t.Fatalf("Expected index: 3\nGot: %d", i)
+	}
+	if i := IndexOf(list, 5); i != -1 {
+		t.Fatalf("Expected index: -1\nGot: %d", i)
+	}
+
+	if !Contains(list, 3) || Contains(list, 0) || !list.ContainsFunc(even) {
+		t.Fatal("Expected contains 3, not 0 and even value")
+	}
+}
+
+func TestAt(t *testing.T) {
+	t.Parallel()
+
+	list := From(0, 1, 2, 3, 4)
+	for i := 0; i < list.Length; i++ {
+		if v, ok := list.At(i); !ok || v != i {
+			t.Fatalf("Expected value at %d: %d\nGot: %d, %t", i, i, v, ok)
+		}
+	}
+
+	for _, i := range []int{-1, 5} {
+		if _, ok := list.At(i); ok || list.NodeAt(i) != nil {
+			t.Fatalf("Expected out of range at: %d", i)
+		}
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	t.Parallel()
+
+	list := New[int]()
+	list.InsertAt(0, 2)
+	list.InsertAt(0, 0)
+	list.InsertAt(1, 1)
+	list.InsertAt(3, 3)
+
+	if list.InsertAt(5, 5) != nil || list.InsertAt(-1, 5) != nil {
+		t.Fatal("Expected nil insert out of range")
+	}
+
+	exp := []int{0, 1, 2, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Tail.Value != 3 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	t.Parallel()
+
+	list := From(1, 2, 3, 4, 6)
+	if n := list.RemoveIf(func(v int) bool { return v%2 == 0 }); n != 3 {
+		t.Fatalf("Expected removed: 3\nGot: %d", n)
+	}
+
+	exp := []int{1, 3}
+	if got := list.ToSlice(); !reflect.DeepEqual(exp, got) || list.Length != 2 || list.Tail.Value != 3 {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	list.RemoveIf(func(int) bool { return true })
+	if list.Head != nil || list.Tail != nil || list.Length != 0 {
+		t.Fatal("Expected empty list\nGot: ", list)
+	}
+}
+
+func TestSublist(t *testing.T) {
+	t.Parallel()
+
+	list := From(0, 1, 2, 3, 4)
+
+	exp := []int{1, 2, 3}
+	if got := list.Sublist(1, 4).ToSlice(); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected values: %v\nGot: %v", exp, got)
+	}
+
+	if sub := list.Sublist(2, 2); sub == nil || sub.Length != 0 {
+		t.Fatal("Expected empty sublist\nGot: ", sub)
+	}
+
+	if list.Sublist(3, 2) != nil || list.Sublist(0, 6) != nil {
+		t.Fatal("Expected nil sublist of invalid range")
+	}
+}
@@ -1,6 +1,13 @@
 package singlylinkedlist
 
-import "fmt"
+import (
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/ownership"
+)
+
+var _ containers.Sequence[any] = (*List[any])(nil)
 
 // List - represents a singly-linked list,
 // that holds values of any type.
@@ -10,6 +17,11 @@ type List[T any] struct {
 	// Tail - last element of linked list.
 	Tail   *Node[T]
 	Length int
+
+	// own - ownership token of List Node's.
+	own ownership.Owner[List[T]]
+	// pool - allocator of List Node's, nil for heap allocation.
+	pool *Pool[T]
 }
 
 // Node - represents a singly-linked Node,
@@ -17,155 +29,279 @@ type List[T any] struct {
 type Node[T any] struct {
 	Value T
 	Next  *Node[T]
+
+	// owner - ownership token of List the Node belongs to,
+	// nil for Node's created by AddNode.
+	owner *ownership.Token[List[T]]
 }
 
-// New - returns new List.
-func New[T any]() *List[T] {
-	head := &Node[T]{}
+// owner - returns ownership token of List, creates it if needed.
+func (l *List[T]) owner() *ownership.Token[List[T]] {
+	return l.own.Token(l)
+}
+
+// owns - returns true, if n is an element of List.
+func (l *List[T]) owns(n *Node[T]) bool {
+	return n != nil && n.owner.Resolve() == l
+}
+
+// New - returns new empty List, configured with opts.
+// Zero value of List is an empty List too.
+func New[T any](opts ...Option[T]) *List[T] {
+	l := &List[T]{}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// From - returns List of provided values, from Head to Tail.
+func From[T any](values ...T) *List[T] {
+	l := New[T]()
+	for _, v := range values {
+		l.Append(v)
+	}
+	return l
+}
 
-	return &List[T]{
-		Head:   head,
-		Tail:   head.Next,
-		Length: 0,
+// FromSeq - returns List of values, collected from seq.
+func FromSeq[T any](seq iter.Seq[T]) *List[T] {
+	l := New[T]()
+	for v := range seq {
+		l.Append(v)
 	}
+	return l
 }
 
-// Append - adds new Tail to List, after last Tail.
+// Append - adds new Tail to List, after last Tail in O(1).
 func (l *List[T]) Append(v T) {
-	if l.Head == nil {
-		return
+	l.insertNode(l.newNode(v), l.Tail)
+}
+
+// PushBack - the same as Append.
+func (l *List[T]) PushBack(v T) {
+	l.Append(v)
+}
+
+// Prepend - adds new Head to List, before first Head in O(1).
+func (l *List[T]) Prepend(v T) {
+	l.insertNode(l.newNode(v), nil)
+}
+
+// PushFront - the same as Prepend.
+func (l *List[T]) PushFront(v T) {
+	l.Prepend(v)
+}
+
+// InsertAfter - adds new Node with value v after mark in O(1),
+// returns new Node. If mark is not an element of List,
+// List is not modified and nil is returned.
+func (l *List[T]) InsertAfter(v T, mark *Node[T]) *Node[T] {
+	if !l.owns(mark) {
+		return nil
 	}
+	return l.insertNode(l.newNode(v), mark)
+}
 
-	ptr, tail := l.Head, AddNode(v)
-	for {
-		if ptr.Next == nil {
-			ptr.Next = tail
-			break
-		}
-		ptr = ptr.Next
+// InsertBefore - adds new Node with value v before mark in O(n),
+// returns new Node. If mark is not an element of List,
+// List is not modified and nil is returned.
+func (l *List[T]) InsertBefore(v T, mark *Node[T]) *Node[T] {
+	if !l.owns(mark) {
+		return nil
 	}
+	return l.insertNode(l.newNode(v), l.prev(mark))
+}
 
-	l.Tail = tail
-	l.Length++
+// Remove - removes n from List in O(n), returns its value and true.
+// If n is not an element of List, returns zero value and false.
+func (l *List[T]) Remove(n *Node[T]) (T, bool) {
+	if !l.owns(n) {
+		var zero T
+		return zero, false
+	}
+
+	v := n.Value
+	l.unlink(n, l.prev(n))
+	l.release(n)
+	return v, true
 }
 
-// Insert - adds new Node, on position after current Node.
-// If any Node exists next to current, it becomes next to new.
-// Method disconnected with List type, will not affect Tail or Length.
-func (l *Node[T]) Insert(v T) {
-	if l == nil {
+// MoveToFront - moves n to the front of List in O(n).
+// If n is not an element of List, List is not modified.
+func (l *List[T]) MoveToFront(n *Node[T]) {
+	if !l.owns(n) || l.Head == n {
 		return
 	}
 
-	tmp := l.Next
-	l.Next = AddNode[T](v)
-	l.Next.Next = tmp
+	l.unlink(n, l.prev(n))
+	l.insertNode(n, nil)
 }
 
-// AddNode - returns new Node with provided value.
-func AddNode[T any](v T) *Node[T] {
-	return &Node[T]{Value: v}
+// MoveToBack - moves n to the back of List in O(n).
+// If n is not an element of List, List is not modified.
+func (l *List[T]) MoveToBack(n *Node[T]) {
+	if !l.owns(n) || l.Tail == n {
+		return
+	}
+
+	l.unlink(n, l.prev(n))
+	l.insertNode(n, l.Tail)
 }
 
-// FillWithRange - generate List from provided range.
-func FillWithRange(l *List[int], from, to int) {
-	l.Head.Value = from
-	l.Length++
+// insertNode - links n after provided Node, or as Head if after is nil.
+// Keeps Head, Tail and Length consistent, returns n.
+func (l *List[T]) insertNode(n, after *Node[T]) *Node[T] {
+	if after == nil {
+		n.Next = l.Head
+		l.Head = n
+	} else {
+		n.Next = after.Next
+		after.Next = n
+	}
 
-	ptr := l.Head
-	for i := from + 1; i <= to; i++ {
-		if ptr.Next == nil {
-			node := AddNode[int](i)
-			ptr.Next = node
-			l.Length++
-
-			if i == to {
-				l.Tail = node
-			}
-		}
-		ptr = ptr.Next
+	if n.Next == nil {
+		l.Tail = n
 	}
+
+	n.owner = l.owner()
+	l.Length++
+	return n
 }
 
-// FillWithInts - generate List from provided slice.
-func FillWithInts(l *List[int], a []int) {
-	if len(a) == 0 {
-		return
+// unlink - removes n, that follows prev, from List,
+// keeping Head, Tail and Length consistent.
+func (l *List[T]) unlink(n, prev *Node[T]) {
+	if prev != nil {
+		prev.Next = n.Next
+	} else {
+		l.Head = n.Next
 	}
 
-	l.Head.Value = a[0]
-	l.Length++
+	if l.Tail == n {
+		l.Tail = prev
+	}
+
+	n.Next, n.owner = nil, nil
+	l.Length--
+}
+
+// prev - returns Node before n, or nil if n is Head.
+func (l *List[T]) prev(n *Node[T]) *Node[T] {
+	if l.Head == n {
+		return nil
+	}
 
 	ptr := l.Head
-	for i, el := range a[1:] {
-		if ptr.Next == nil {
-			node := AddNode[int](el)
-			ptr.Next = node
-			l.Length++
-
-			if i == len(a[1:])-1 {
-				l.Tail = node
-			}
-		}
+	for ptr.Next != n {
 		ptr = ptr.Next
 	}
+	return ptr
 }
 
-// FillWithStrings - generate List from provided strings.
-func FillWithStrings(l *List[string], s ...string) {
-	if len(s) == 0 {
-		return
-	}
+// Add - appends value to List, implements containers.Collection.
+func (l *List[T]) Add(v T) bool {
+	l.Append(v)
+	return true
+}
 
-	l.Head.Value = s[0]
-	l.Length++
+// Len - returns count of List elements.
+func (l *List[T]) Len() int {
+	return l.Length
+}
 
-	ptr := l.Head
-	for i, el := range s[1:] {
-		if ptr.Next == nil {
-			node := AddNode[string](el)
-			ptr.Next = node
-			l.Length++
-
-			if i == len(s[1:])-1 {
-				l.Tail = node
-			}
+// IsEmpty - returns true, if List has no elements.
+func (l *List[T]) IsEmpty() bool {
+	return l.Length == 0
+}
+
+// Clear - removes all elements from List in O(1),
+// or in O(n) if List returns Node's to Pool.
+func (l *List[T]) Clear() {
+	if l.pool != nil {
+		for ptr := l.Head; ptr != nil; {
+			next := ptr.Next
+			l.release(ptr)
+			ptr = next
 		}
-		ptr = ptr.Next
 	}
+
+	l.own.Release()
+	l.Head, l.Tail, l.Length = nil, nil, 0
 }
 
-// PrintList - prints all Node's, from Head to Tail.
-func (l *List[T]) PrintList() {
+// Front - returns Head value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Front() (T, bool) {
 	if l.Head == nil {
-		fmt.Println(nil)
+		var zero T
+		return zero, false
 	}
-	ptr := l.Head
+	return l.Head.Value, true
+}
+
+// Back - returns Tail value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Back() (T, bool) {
+	if l.Tail == nil {
+		var zero T
+		return zero, false
+	}
+	return l.Tail.Value, true
+}
+
+// ToSlice - returns values of all Node's, from Head to Tail.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.Length)
+	for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+		values = append(values, ptr.Value)
+	}
+	return values
+}
 
-	for {
-		ptr.PrintNode()
-		if ptr.Next == nil {
-			break
+// All - returns iterator over Node's values with their indexes, from Head to Tail.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+			if !yield(i, ptr.Value) {
+				return
+			}
+			i++
 		}
-		ptr = ptr.Next
 	}
+}
 
-	fmt.Println("Length:", l.Length)
+// Values - returns iterator over Node's values, from Head to Tail.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for ptr := l.Head; ptr != nil; ptr = ptr.Next {
+			if !yield(ptr.Value) {
+				return
+			}
+		}
+	}
 }
 
-// PrintNode - print Node Value, and its Next Node Value
-func (l *Node[T]) PrintNode() {
+// Insert - adds new Node, on position after current Node.
+// If any Node exists next to current, it becomes next to new.
+// If current Node belongs to List, its Tail and Length are updated.
+func (l *Node[T]) Insert(v T) {
 	if l == nil {
-		fmt.Print(nil)
 		return
 	}
 
-	fmt.Printf("%v->%v ",
-		l.Value,
-		func() any {
-			if l.Next != nil {
-				return l.Next.Value
-			}
-			return nil
-		}())
+	if list := l.owner.Resolve(); list != nil {
+		list.InsertAfter(v, l)
+		return
+	}
+
+	tmp := l.Next
+	l.Next = AddNode[T](v)
+	l.Next.Next = tmp
+}
+
+// AddNode - returns new Node with provided value.
+func AddNode[T any](v T) *Node[T] {
+	return &Node[T]{Value: v}
 }
@@ -0,0 +1,141 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Map[int, any] = (*CSkipList[int, any])(nil)
+
+// CSkipList - concurrent-safety SkipList, guarded by read-write mutex.
+type CSkipList[K, V any] struct {
+	mu sync.RWMutex
+	s  *SkipList[K, V]
+}
+
+// entry - key and value pair, collected for snapshot iteration.
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// NewConcurrent - returns new CSkipList, ordered by natural order of keys.
+func NewConcurrent[K cmp.Ordered, V any](opts ...Option) *CSkipList[K, V] {
+	return &CSkipList[K, V]{s: New[K, V](opts...)}
+}
+
+// NewConcurrentFunc - returns new CSkipList, ordered by compare.
+func NewConcurrentFunc[K, V any](compare func(a, b K) int, opts ...Option) *CSkipList[K, V] {
+	return &CSkipList[K, V]{s: NewFunc[K, V](compare, opts...)}
+}
+
+// Insert - creates new entry with provided key, value,
+// or replaces value of existing one.
+func (c *CSkipList[K, V]) Insert(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.s.Insert(key, value)
+}
+
+// Get - returns value lying at provided key and true,
+// if entry with key not exist, returns zero value and false.
+func (c *CSkipList[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.s.Get(key)
+}
+
+// Update - returns true, if value for key are set, else returns false.
+func (c *CSkipList[K, V]) Update(key K, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.s.Update(key, value)
+}
+
+// Delete - returns true, if entry by provided key are deleted,
+// else return false.
+func (c *CSkipList[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.s.Delete(key)
+}
+
+// Len - returns count of entries.
+func (c *CSkipList[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.s.Len()
+}
+
+// IsEmpty - returns true, if there are no entries.
+func (c *CSkipList[K, V]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+// Clear - deletes all entries.
+func (c *CSkipList[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.s.Clear()
+}
+
+// Floor - returns entry with the greatest key less than or equal
+// to provided key, if there is no such entry, returns false.
+func (c *CSkipList[K, V]) Floor(key K) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.s.Floor(key)
+}
+
+// Ceiling - returns entry with the least key greater than or equal
+// to provided key, if there is no such entry, returns false.
+func (c *CSkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.s.Ceiling(key)
+}
+
+// All - returns iterator over snapshot of entries in increasing order
+// of keys, CSkipList can be modified during iteration.
+func (c *CSkipList[K, V]) All() iter.Seq2[K, V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.snapshot(c.s.All())
+}
+
+// Range - returns iterator over snapshot of entries with keys in [from, to),
+// in increasing order, CSkipList can be modified during iteration.
+func (c *CSkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.snapshot(c.s.Range(from, to))
+}
+
+// snapshot - collects entries of seq, returns iterator over them.
+func (c *CSkipList[K, V]) snapshot(seq iter.Seq2[K, V]) iter.Seq2[K, V] {
+	var entries []entry[K, V]
+	for k, v := range seq {
+		entries = append(entries, entry[K, V]{k, v})
+	}
+
+	return func(yield func(K, V) bool) {
+		for _, e := range entries {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
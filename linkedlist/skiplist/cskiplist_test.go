@@ -0,0 +1,51 @@
+package skiplist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestCSkipList(t *testing.T) {
+	t.Parallel()
+
+	c := NewConcurrent[int, int](WithSeed(6))
+	wg := sync.WaitGroup{}
+
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := w * 100; i < (w+1)*100; i++ {
+				c.Insert(i, i)
+				c.Get(i)
+				c.Floor(i)
+			}
+			for i := w * 100; i < (w+1)*100; i += 2 {
+				c.Delete(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.Len() != 400 {
+		t.Fatalf("Expected length: 400\nGot: %d", c.Len())
+	}
+
+	var keys []int
+	for k := range c.Range(10, 16) {
+		c.Delete(k) // snapshot iteration allows modification
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{11, 13, 15}) {
+		t.Fatalf("Expected: %v\nGot: %v", []int{11, 13, 15}, keys)
+	}
+	if k, _, ok := c.Ceiling(10); !ok || k != 17 {
+		t.Fatalf("Expected ceiling: 17\nGot: %d, %v", k, ok)
+	}
+
+	c.Clear()
+	if !c.IsEmpty() {
+		t.Fatalf("Expected empty CSkipList after Clear")
+	}
+}
@@ -0,0 +1,285 @@
+// Package skiplist - ordered map, based on skip list.
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Map[int, any] = (*SkipList[int, any])(nil)
+
+// Default configuration of SkipList.
+const (
+	DefaultProbability = 0.5
+	DefaultMaxLevel    = 32
+)
+
+// SkipList - represents an ordered map, built of singly-linked Node's
+// with towers of forward links. Insert, Get and Delete take
+// O(log(n)) expected time.
+type SkipList[K, V any] struct {
+	// head - sentinel Node with the tower of max level.
+	head *Node[K, V]
+	// level - count of used levels.
+	level  int
+	length int
+	// prev - scratch for the last Node's before key on every level,
+	// reused by Insert and Delete to avoid allocation per operation.
+	prev []*Node[K, V]
+
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b K) int
+
+	probability float64
+	maxLevel    int
+	rnd         *rand.Rand
+}
+
+// Node - represents a SkipList Node, that holds key and value.
+type Node[K, V any] struct {
+	Key   K
+	Value V
+	// next - forward links, next[0] is the next Node in key order.
+	next []*Node[K, V]
+}
+
+// Next - returns the next Node in key order, or nil.
+func (n *Node[K, V]) Next() *Node[K, V] {
+	return n.next[0]
+}
+
+// Option - configures SkipList, provided in New.
+type Option func(*options)
+
+type options struct {
+	probability float64
+	maxLevel    int
+	rnd         *rand.Rand
+}
+
+// WithProbability - sets probability of Node to get the next level,
+// must be in (0, 1). Lower probability saves memory, but slows search.
+func WithProbability(p float64) Option {
+	return func(o *options) {
+		if p > 0 && p < 1 {
+			o.probability = p
+		}
+	}
+}
+
+// WithMaxLevel - sets max height of Node towers.
+func WithMaxLevel(level int) Option {
+	return func(o *options) {
+		if level > 0 {
+			o.maxLevel = level
+		}
+	}
+}
+
+// WithSeed - makes levels of Node's deterministic, to reproduce tests.
+func WithSeed(seed uint64) Option {
+	return func(o *options) {
+		o.rnd = rand.New(rand.NewPCG(seed, seed))
+	}
+}
+
+// New - returns new SkipList, ordered by natural order of keys.
+func New[K cmp.Ordered, V any](opts ...Option) *SkipList[K, V] {
+	return NewFunc[K, V](cmp.Compare[K], opts...)
+}
+
+// NewFunc - returns new SkipList, ordered by compare.
+func NewFunc[K, V any](compare func(a, b K) int, opts ...Option) *SkipList[K, V] {
+	o := options{probability: DefaultProbability, maxLevel: DefaultMaxLevel}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.rnd == nil {
+		o.rnd = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	return &SkipList[K, V]{
+		head:        &Node[K, V]{next: make([]*Node[K, V], o.maxLevel)},
+		level:       1,
+		prev:        make([]*Node[K, V], o.maxLevel),
+		compare:     compare,
+		probability: o.probability,
+		maxLevel:    o.maxLevel,
+		rnd:         o.rnd,
+	}
+}
+
+// randomLevel - returns height of new Node tower.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < s.maxLevel && s.rnd.Float64() < s.probability {
+		level++
+	}
+	return level
+}
+
+// findPrev - fills prev with the last Node before key on every level,
+// returns the first Node with key not less than provided.
+func (s *SkipList[K, V]) findPrev(key K, prev []*Node[K, V]) *Node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].Key, key) < 0 {
+			x = x.next[i]
+		}
+		if prev != nil {
+			prev[i] = x
+		}
+	}
+	return x.next[0]
+}
+
+// find - returns Node with provided key, or nil.
+func (s *SkipList[K, V]) find(key K) *Node[K, V] {
+	if n := s.findPrev(key, nil); n != nil && s.compare(n.Key, key) == 0 {
+		return n
+	}
+	return nil
+}
+
+// Insert - creates new entry with provided key, value,
+// or replaces value of existing one.
+func (s *SkipList[K, V]) Insert(key K, value V) {
+	prev := s.prev
+	defer clear(prev)
+
+	if n := s.findPrev(key, prev); n != nil && s.compare(n.Key, key) == 0 {
+		n.Value = value
+		return
+	}
+
+	level := s.randomLevel()
+	for ; s.level < level; s.level++ {
+		prev[s.level] = s.head
+	}
+
+	n := &Node[K, V]{Key: key, Value: value, next: make([]*Node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i], prev[i].next[i] = prev[i].next[i], n
+	}
+	s.length++
+}
+
+// Get - returns value lying at provided key and true,
+// if entry with key not exist, returns zero value and false.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.find(key); n != nil {
+		return n.Value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Update - returns true, if value for key are set, else returns false.
+func (s *SkipList[K, V]) Update(key K, value V) bool {
+	if n := s.find(key); n != nil {
+		n.Value = value
+		return true
+	}
+	return false
+}
+
+// Delete - returns true, if entry by provided key are deleted,
+// else return false.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	prev := s.prev
+	defer clear(prev)
+
+	n := s.findPrev(key, prev)
+	if n == nil || s.compare(n.Key, key) != 0 {
+		return false
+	}
+
+	for i := range n.next {
+		prev[i].next[i] = n.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.length--
+	return true
+}
+
+// Len - returns count of entries.
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+// IsEmpty - returns true, if there are no entries.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.length == 0
+}
+
+// Clear - deletes all entries.
+func (s *SkipList[K, V]) Clear() {
+	clear(s.head.next)
+	s.level, s.length = 1, 0
+}
+
+// First - returns Node with the least key, or nil if SkipList is empty.
+func (s *SkipList[K, V]) First() *Node[K, V] {
+	return s.head.next[0]
+}
+
+// Floor - returns entry with the greatest key less than or equal
+// to provided key, if there is no such entry, returns false.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].Key, key) <= 0 {
+			x = x.next[i]
+		}
+	}
+
+	if x == s.head {
+		var zero Node[K, V]
+		return zero.Key, zero.Value, false
+	}
+	return x.Key, x.Value, true
+}
+
+// Ceiling - returns entry with the least key greater than or equal
+// to provided key, if there is no such entry, returns false.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	n := s.findPrev(key, nil)
+	if n == nil {
+		var zero Node[K, V]
+		return zero.Key, zero.Value, false
+	}
+	return n.Key, n.Value, true
+}
+
+// All - returns iterator over entries in increasing order of keys.
+// SkipList must not be modified during iteration.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.Key, n.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Range - returns iterator over entries with keys in [from, to),
+// in increasing order. SkipList must not be modified during iteration.
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.findPrev(from, nil); n != nil && s.compare(n.Key, to) < 0; n = n.next[0] {
+			if !yield(n.Key, n.Value) {
+				return
+			}
+		}
+	}
+}
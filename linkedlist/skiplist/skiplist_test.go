@@ -0,0 +1,228 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// levels - returns tower heights of Node's in key order.
+func levels[K, V any](s *SkipList[K, V]) []int {
+	var result []int
+	for n := s.First(); n != nil; n = n.Next() {
+		result = append(result, len(n.next))
+	}
+	return result
+}
+
+// checkOrder - fails if any level of s is not sorted or Len is wrong.
+func checkOrder(t *testing.T, s *SkipList[int, int]) {
+	t.Helper()
+
+	for i := 0; i < s.level; i++ {
+		count := 0
+		for n := s.head.next[i]; n != nil; n = n.next[i] {
+			if next := n.next[i]; next != nil && next.Key <= n.Key {
+				t.Fatalf("Expected increasing keys on level %d\nGot: %d before %d", i, n.Key, next.Key)
+			}
+			count++
+		}
+		if i == 0 && count != s.Len() {
+			t.Fatalf("Expected length: %d\nGot: %d", count, s.Len())
+		}
+	}
+}
+
+func TestInsertGet(t *testing.T) {
+	t.Parallel()
+
+	s := New[int, string](WithSeed(1))
+	for _, k := range []int{5, 1, 3, 9, 7} {
+		s.Insert(k, "v")
+	}
+	s.Insert(3, "three")
+
+	if s.Len() != 5 {
+		t.Fatalf("Expected length: 5\nGot: %d", s.Len())
+	}
+	if v, ok := s.Get(3); !ok || v != "three" {
+		t.Fatalf("Expected: three, true\nGot: %s, %v", v, ok)
+	}
+	if _, ok := s.Get(4); ok {
+		t.Fatalf("Expected missing key: 4")
+	}
+	if s.Update(4, "four") || !s.Update(1, "one") {
+		t.Fatalf("Expected update of existing key only")
+	}
+
+	keys := slices.Collect(func(yield func(int) bool) {
+		for k := range s.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	})
+	if !reflect.DeepEqual(keys, []int{1, 3, 5, 7, 9}) {
+		t.Fatalf("Expected: %v\nGot: %v", []int{1, 3, 5, 7, 9}, keys)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	s := New[int, int](WithSeed(2))
+	for i := range 100 {
+		s.Insert(i, i)
+	}
+	for i := 0; i < 100; i += 2 {
+		if !s.Delete(i) {
+			t.Fatalf("Expected delete of key: %d", i)
+		}
+	}
+	if s.Delete(0) {
+		t.Fatalf("Expected no delete of missing key: 0")
+	}
+	checkOrder(t, s)
+
+	s.Clear()
+	if !s.IsEmpty() || s.First() != nil || s.level != 1 {
+		t.Fatalf("Expected empty SkipList after Clear")
+	}
+}
+
+// TestScratch - not parallel, allocations are counted for the whole process.
+func TestScratch(t *testing.T) {
+	s := New[int, int](WithSeed(3))
+	for i := range 1000 {
+		s.Insert(i, i)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { s.Insert(500, 0) }); allocs != 0 {
+		t.Fatalf("Expected no allocations for existing key\nGot: %v", allocs)
+	}
+	key := 0
+	if allocs := testing.AllocsPerRun(100, func() { s.Delete(key); key++ }); allocs != 0 {
+		t.Fatalf("Expected no allocations for Delete\nGot: %v", allocs)
+	}
+	checkOrder(t, s)
+
+	for i, n := range s.prev {
+		if n != nil {
+			t.Fatalf("Expected cleared scratch\nGot: Node %d at level %d", n.Key, i)
+		}
+	}
+}
+
+func TestFloorCeiling(t *testing.T) {
+	t.Parallel()
+
+	s := New[int, string](WithSeed(3))
+	for _, k := range []int{10, 20, 30} {
+		s.Insert(k, "")
+	}
+
+	tests := []struct {
+		key               int
+		floor, ceiling    int
+		hasFloor, hasCeil bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+	for _, tt := range tests {
+		if k, _, ok := s.Floor(tt.key); k != tt.floor || ok != tt.hasFloor {
+			t.Fatalf("Expected floor of %d: %d, %v\nGot: %d, %v", tt.key, tt.floor, tt.hasFloor, k, ok)
+		}
+		if k, _, ok := s.Ceiling(tt.key); k != tt.ceiling || ok != tt.hasCeil {
+			t.Fatalf("Expected ceiling of %d: %d, %v\nGot: %d, %v", tt.key, tt.ceiling, tt.hasCeil, k, ok)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	s := New[int, int](WithSeed(4))
+	for i := 0; i < 20; i += 2 {
+		s.Insert(i, i*i)
+	}
+
+	var keys []int
+	for k, v := range s.Range(3, 11) {
+		if v != k*k {
+			t.Fatalf("Expected value: %d\nGot: %d", k*k, v)
+		}
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []int{4, 6, 8, 10}) {
+		t.Fatalf("Expected: %v\nGot: %v", []int{4, 6, 8, 10}, keys)
+	}
+
+	for range s.Range(11, 3) {
+		t.Fatalf("Expected empty range")
+	}
+}
+
+func TestSeed(t *testing.T) {
+	t.Parallel()
+
+	a := New[int, int](WithSeed(42), WithProbability(0.25), WithMaxLevel(8))
+	b := New[int, int](WithSeed(42), WithProbability(0.25), WithMaxLevel(8))
+	for i := range 200 {
+		a.Insert(i, i)
+		b.Insert(i, i)
+	}
+
+	if !reflect.DeepEqual(levels(a), levels(b)) {
+		t.Fatalf("Expected equal levels with equal seeds")
+	}
+	if lv := slices.Max(levels(a)); lv > 8 {
+		t.Fatalf("Expected max level: 8\nGot: %d", lv)
+	}
+}
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(5, 5))
+	s := New[int, int](WithSeed(5))
+	model := map[int]int{}
+
+	for range 5000 {
+		k := rnd.IntN(500)
+		switch rnd.IntN(3) {
+		case 0, 1:
+			s.Insert(k, -k)
+			model[k] = -k
+		case 2:
+			_, exist := model[k]
+			if s.Delete(k) != exist {
+				t.Fatalf("Expected delete of key %d: %v", k, exist)
+			}
+			delete(model, k)
+		}
+	}
+	checkOrder(t, s)
+
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	var got []int
+	for k, v := range s.All() {
+		if v != model[k] {
+			t.Fatalf("Expected value by key %d: %d\nGot: %d", k, model[k], v)
+		}
+		got = append(got, k)
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Fatalf("Expected keys: %v\nGot: %v", keys, got)
+	}
+}
@@ -0,0 +1,298 @@
+// Package unrolledlist - doubly-linked list of blocks, each holding
+// several values, that is denser in memory and faster to iterate
+// than list of one value Node's.
+package unrolledlist
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Sequence[any] = (*List[any])(nil)
+
+// DefaultBlockSize - max count of values in one block by default.
+const DefaultBlockSize = 64
+
+// List - represents an unrolled linked list, blocks of values
+// are linked in both directions. Access by index takes O(n/B),
+// where B is block size.
+type List[T any] struct {
+	head, tail *block[T]
+	length     int
+	blockSize  int
+}
+
+// block - linked node, that holds up to blockSize values.
+type block[T any] struct {
+	values     []T
+	prev, next *block[T]
+}
+
+// New - returns new empty List with provided block size,
+// if size is not positive, DefaultBlockSize is used.
+// Zero value of List is an empty List with DefaultBlockSize.
+func New[T any](blockSize int) *List[T] {
+	return &List[T]{blockSize: blockSize}
+}
+
+// From - returns List of provided values with DefaultBlockSize.
+func From[T any](values ...T) *List[T] {
+	l := New[T](DefaultBlockSize)
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// size - returns max count of values in block.
+func (l *List[T]) size() int {
+	if l.blockSize <= 0 {
+		return DefaultBlockSize
+	}
+	return l.blockSize
+}
+
+// newBlock - links new empty block after provided one,
+// or as head if after is nil, returns new block.
+func (l *List[T]) newBlock(after *block[T]) *block[T] {
+	b := &block[T]{values: make([]T, 0, l.size()), prev: after}
+
+	if after == nil {
+		b.next, l.head = l.head, b
+	} else {
+		b.next, after.next = after.next, b
+	}
+
+	if b.next != nil {
+		b.next.prev = b
+	} else {
+		l.tail = b
+	}
+	return b
+}
+
+// unlinkBlock - removes b from List.
+func (l *List[T]) unlinkBlock(b *block[T]) {
+	if b.prev != nil {
+		b.prev.next = b.next
+	} else {
+		l.head = b.next
+	}
+
+	if b.next != nil {
+		b.next.prev = b.prev
+	} else {
+		l.tail = b.prev
+	}
+	b.prev, b.next = nil, nil
+}
+
+// locate - returns block, holding value at index i, and index in block.
+// Walks from the nearest end of List. i must be in range [0, Length).
+func (l *List[T]) locate(i int) (*block[T], int) {
+	if i < l.length/2 {
+		b := l.head
+		for i >= len(b.values) {
+			i -= len(b.values)
+			b = b.next
+		}
+		return b, i
+	}
+
+	b, i := l.tail, l.length-i
+	for i > len(b.values) {
+		i -= len(b.values)
+		b = b.prev
+	}
+	return b, len(b.values) - i
+}
+
+// PushBack - adds value to the back of List in O(1).
+func (l *List[T]) PushBack(v T) {
+	b := l.tail
+	if b == nil || len(b.values) == cap(b.values) {
+		b = l.newBlock(l.tail)
+	}
+
+	b.values = append(b.values, v)
+	l.length++
+}
+
+// PushFront - adds value to the front of List in O(B).
+func (l *List[T]) PushFront(v T) {
+	b := l.head
+	if b == nil || len(b.values) == cap(b.values) {
+		b = l.newBlock(nil)
+	}
+
+	b.values = slices.Insert(b.values, 0, v)
+	l.length++
+}
+
+// Insert - adds value at index i, where 0 <= i <= Length, in O(n/B+B).
+// Returns false if i is out of range.
+func (l *List[T]) Insert(i int, v T) bool {
+	switch {
+	case i < 0 || i > l.length:
+		return false
+	case i == l.length:
+		l.PushBack(v)
+		return true
+	}
+
+	b, j := l.locate(i)
+	if len(b.values) == cap(b.values) {
+		// split full block into halves
+		half := len(b.values) / 2
+		next := l.newBlock(b)
+		next.values = append(next.values, b.values[half:]...)
+		clear(b.values[half:])
+		b.values = b.values[:half]
+
+		if j > half {
+			b, j = next, j-half
+		}
+	}
+
+	b.values = slices.Insert(b.values, j, v)
+	l.length++
+	return true
+}
+
+// At - returns value at index i in O(n/B),
+// if i is out of range, returns zero value and false.
+func (l *List[T]) At(i int) (T, bool) {
+	if i < 0 || i >= l.length {
+		var zero T
+		return zero, false
+	}
+
+	b, j := l.locate(i)
+	return b.values[j], true
+}
+
+// Set - replaces value at index i in O(n/B), returns false if i is out of range.
+func (l *List[T]) Set(i int, v T) bool {
+	if i < 0 || i >= l.length {
+		return false
+	}
+
+	b, j := l.locate(i)
+	b.values[j] = v
+	return true
+}
+
+// RemoveAt - removes value at index i in O(n/B+B), returns it and true.
+// If i is out of range, returns zero value and false.
+// Block, that becomes less than half full, is merged with the next one,
+// if they fit in one block.
+func (l *List[T]) RemoveAt(i int) (T, bool) {
+	if i < 0 || i >= l.length {
+		var zero T
+		return zero, false
+	}
+
+	b, j := l.locate(i)
+	v := b.values[j]
+
+	b.values = slices.Delete(b.values, j, j+1)
+	l.length--
+
+	switch next := b.next; {
+	case len(b.values) == 0:
+		l.unlinkBlock(b)
+	case next != nil && len(b.values) < cap(b.values)/2 &&
+		len(b.values)+len(next.values) <= cap(b.values):
+		b.values = append(b.values, next.values...)
+		l.unlinkBlock(next)
+	}
+
+	return v, true
+}
+
+// Add - appends value to List, implements containers.Collection.
+func (l *List[T]) Add(v T) bool {
+	l.PushBack(v)
+	return true
+}
+
+// Len - returns count of List values.
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+// IsEmpty - returns true, if List has no values.
+func (l *List[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
+// Clear - removes all values from List.
+func (l *List[T]) Clear() {
+	l.head, l.tail, l.length = nil, nil, 0
+}
+
+// Front - returns the first value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Front() (T, bool) {
+	return l.At(0)
+}
+
+// Back - returns the last value,
+// if List is empty, returns zero value and false.
+func (l *List[T]) Back() (T, bool) {
+	return l.At(l.length - 1)
+}
+
+// ToSlice - returns all values, from front to back.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.length)
+	for b := l.head; b != nil; b = b.next {
+		values = append(values, b.values...)
+	}
+	return values
+}
+
+// All - returns iterator over values with their indexes, from front to back.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for b := l.head; b != nil; b = b.next {
+			for _, v := range b.values {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Backward - returns iterator over values with their indexes, from back to front.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.length - 1
+		for b := l.tail; b != nil; b = b.prev {
+			for j := len(b.values) - 1; j >= 0; j-- {
+				if !yield(i, b.values[j]) {
+					return
+				}
+				i--
+			}
+		}
+	}
+}
+
+// Values - returns iterator over values, from front to back.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := l.head; b != nil; b = b.next {
+			for _, v := range b.values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
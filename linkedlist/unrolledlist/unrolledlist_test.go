@@ -0,0 +1,225 @@
+package unrolledlist
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/doublylinkedlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/indexlist"
+	"github.com/seriozhakorneev/go-data-structures/linkedlist/singlylinkedlist"
+)
+
+// checkBlocks - checks links of blocks, their fill and Length.
+func checkBlocks[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+
+	count, prev := 0, (*block[T])(nil)
+	for b := l.head; b != nil; b = b.next {
+		if b.prev != prev {
+			t.Fatalf("Expected prev block: %p\nGot: %p", prev, b.prev)
+		}
+		if len(b.values) == 0 || len(b.values) > l.size() {
+			t.Fatalf("Expected block length in [1, %d]\nGot: %d", l.size(), len(b.values))
+		}
+		count, prev = count+len(b.values), b
+	}
+
+	if count != l.Len() || prev != l.tail {
+		t.Fatalf("Expected length: %d\nGot: %d", count, l.Len())
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	var l List[int]
+	if _, ok := l.Front(); ok {
+		t.Fatalf("Expected zero value to be an empty List")
+	}
+
+	for v := range 100 {
+		l.PushBack(v)
+	}
+	l.PushFront(-1)
+	checkBlocks(t, &l)
+
+	if v, ok := l.At(70); !ok || v != 69 {
+		t.Fatalf("Expected At(70): 69, true\nGot: %d, %v", v, ok)
+	}
+	if _, ok := l.At(101); ok {
+		t.Fatalf("Expected At(101) to be out of range")
+	}
+	if !l.Set(0, -2) || l.Set(-1, 0) {
+		t.Fatalf("Expected Set in range only")
+	}
+	if v, _ := l.Front(); v != -2 {
+		t.Fatalf("Expected front: -2\nGot: %d", v)
+	}
+	if v, _ := l.Back(); v != 99 {
+		t.Fatalf("Expected back: 99\nGot: %d", v)
+	}
+
+	var backward []int
+	for i, v := range l.Backward() {
+		if got, _ := l.At(i); got != v {
+			t.Fatalf("Expected value at %d: %d\nGot: %d", i, got, v)
+		}
+		backward = append(backward, v)
+	}
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(backward, l.ToSlice()) {
+		t.Fatalf("Expected: %v\nGot: %v", l.ToSlice(), backward)
+	}
+
+	l.Clear()
+	if !l.IsEmpty() || l.head != nil {
+		t.Fatalf("Expected empty List after Clear")
+	}
+}
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	l := New[int](4)
+	var model []int
+
+	for op := range 3000 {
+		switch i := rnd.IntN(len(model) + 1); rnd.IntN(4) {
+		case 0, 1:
+			l.Insert(i, op)
+			model = slices.Insert(model, i, op)
+		case 2:
+			l.PushFront(op)
+			model = slices.Insert(model, 0, op)
+		case 3:
+			v, ok := l.RemoveAt(i)
+			if ok != (i < len(model)) {
+				t.Fatalf("Expected RemoveAt(%d) result: %v", i, i < len(model))
+			}
+			if ok {
+				if v != model[i] {
+					t.Fatalf("Expected removed: %d\nGot: %d", model[i], v)
+				}
+				model = slices.Delete(model, i, i+1)
+			}
+		}
+	}
+	checkBlocks(t, l)
+
+	if !reflect.DeepEqual(l.ToSlice(), model) {
+		t.Fatalf("Expected: %v\nGot: %v", model, l.ToSlice())
+	}
+	if got := slices.Collect(l.Values()); !reflect.DeepEqual(got, model) {
+		t.Fatalf("Expected: %v\nGot: %v", model, got)
+	}
+	if l.Insert(-1, 0) || l.Insert(l.Len()+1, 0) {
+		t.Fatalf("Expected Insert out of range to fail")
+	}
+}
+
+// bytesPerElement - returns heap bytes, allocated by fill, per element.
+func bytesPerElement(b *testing.B, n int, fill func()) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < b.N; i++ {
+		fill()
+	}
+	runtime.ReadMemStats(&after)
+
+	b.ReportMetric(float64(after.TotalAlloc-before.TotalAlloc)/float64(b.N*n), "B/elem")
+}
+
+func BenchmarkMemory(b *testing.B) {
+	const n = 100_000
+
+	b.Run("singlylinkedlist", func(b *testing.B) {
+		bytesPerElement(b, n, func() {
+			l := singlylinkedlist.New[int]()
+			for v := range n {
+				l.Append(v)
+			}
+		})
+	})
+	b.Run("doublylinkedlist", func(b *testing.B) {
+		bytesPerElement(b, n, func() {
+			l := doublylinkedlist.New[int]()
+			for v := range n {
+				l.Append(v)
+			}
+		})
+	})
+	b.Run("indexlist", func(b *testing.B) {
+		bytesPerElement(b, n, func() {
+			l := indexlist.New[int]()
+			l.Grow(n)
+			for v := range n {
+				l.PushBack(v)
+			}
+		})
+	})
+	for _, size := range []int{16, 64, 256} {
+		b.Run(fmt.Sprintf("unrolledlist block %d", size), func(b *testing.B) {
+			bytesPerElement(b, n, func() {
+				l := New[int](size)
+				for v := range n {
+					l.PushBack(v)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkIterate(b *testing.B) {
+	const n = 100_000
+
+	doubly := doublylinkedlist.New[int]()
+	unrolled := New[int](DefaultBlockSize)
+	for v := range n {
+		doubly.Append(v)
+		unrolled.PushBack(v)
+	}
+
+	b.Run("doublylinkedlist", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for v := range doubly.Values() {
+				sum += v
+			}
+		}
+	})
+	b.Run("unrolledlist", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for v := range unrolled.Values() {
+				sum += v
+			}
+		}
+	})
+}
+
+func BenchmarkAt(b *testing.B) {
+	const n = 100_000
+
+	doubly := doublylinkedlist.New[int]()
+	unrolled := New[int](DefaultBlockSize)
+	for v := range n {
+		doubly.Append(v)
+		unrolled.PushBack(v)
+	}
+
+	b.Run("doublylinkedlist", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			doubly.At(i % n)
+		}
+	})
+	b.Run("unrolledlist", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			unrolled.At(i % n)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package queue
+
+// MonotonicQueue - FIFO window of elements, that returns
+// its max element in O(1) amortized time.
+// For min element provide reversed compare.
+type MonotonicQueue[T any] struct {
+	// deque - candidates for max, in decreasing order from front.
+	deque []monotonicEntry[T]
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b T) int
+	// pushed, popped - count of elements pushed and popped over all time.
+	pushed, popped int
+}
+
+// monotonicEntry - element with its push sequence number.
+type monotonicEntry[T any] struct {
+	value T
+	seq   int
+}
+
+// NewMonotonic - returns new MonotonicQueue, that orders elements with compare.
+func NewMonotonic[T any](compare func(a, b T) int) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{compare: compare}
+}
+
+func (m *MonotonicQueue[T]) IsEmpty() bool {
+	return m.Len() == 0
+}
+
+// Len - returns count of elements in window.
+func (m *MonotonicQueue[T]) Len() int {
+	return m.pushed - m.popped
+}
+
+// Clear - removes all elements from window.
+func (m *MonotonicQueue[T]) Clear() {
+	m.deque, m.pushed, m.popped = nil, 0, 0
+}
+
+// Push - adds element on back of window,
+// dropping all smaller elements, that can't become max anymore.
+func (m *MonotonicQueue[T]) Push(element T) {
+	for len(m.deque) > 0 && m.compare(m.deque[len(m.deque)-1].value, element) < 0 {
+		m.deque = m.deque[:len(m.deque)-1]
+	}
+
+	m.deque = append(m.deque, monotonicEntry[T]{value: element, seq: m.pushed})
+	m.pushed++
+}
+
+// Pop - removes the oldest element of window, returns false if window is empty.
+func (m *MonotonicQueue[T]) Pop() bool {
+	if m.IsEmpty() {
+		return false
+	}
+
+	if m.deque[0].seq == m.popped {
+		var zero monotonicEntry[T]
+		m.deque[0] = zero
+		m.deque = m.deque[1:]
+	}
+	m.popped++
+	return true
+}
+
+// Max - returns max element of window,
+// if window is empty, returns zero value and false.
+func (m *MonotonicQueue[T]) Max() (T, bool) {
+	if len(m.deque) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return m.deque[0].value, true
+}
+
+// SlidingWindowMax - returns max element of every window
+// of k consecutive elements, in order of windows.
+func SlidingWindowMax[T any](elements []T, k int, compare func(a, b T) int) []T {
+	if k <= 0 || k > len(elements) {
+		return nil
+	}
+
+	m := NewMonotonic(compare)
+	result := make([]T, 0, len(elements)-k+1)
+
+	for i, el := range elements {
+		m.Push(el)
+		if i >= k {
+			m.Pop()
+		}
+		if i >= k-1 {
+			largest, _ := m.Max()
+			result = append(result, largest)
+		}
+	}
+
+	return result
+}
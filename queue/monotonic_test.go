@@ -0,0 +1,55 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func TestMonotonicQueue(t *testing.T) {
+	t.Parallel()
+
+	m := NewMonotonic(compareInts)
+	if _, ok := m.Max(); ok || m.Pop() {
+		t.Fatal("Expected empty queue\nGot: ok")
+	}
+
+	m.Push(3)
+	m.Push(1)
+	m.Push(2)
+
+	for _, exp := range []int{3, 2, 2} {
+		if max, _ := m.Max(); max != exp {
+			t.Fatalf("Expected max: %d\nGot: %d", exp, max)
+		}
+		m.Pop()
+	}
+
+	if !m.IsEmpty() {
+		t.Fatalf("Expected empty queue\nGot size: %d", m.Len())
+	}
+}
+
+func TestSlidingWindowMax(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 3, -1, -3, 5, 3, 6, 7}
+
+	exp := []int{3, 3, 5, 5, 6, 7}
+	if got := SlidingWindowMax(in, 3, compareInts); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected maxima: %v\nGot: %v", exp, got)
+	}
+
+	reversed := func(a, b int) int { return b - a }
+	exp = []int{-1, -3, -3, -3, 3, 3}
+	if got := SlidingWindowMax(in, 3, reversed); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected minima: %v\nGot: %v", exp, got)
+	}
+
+	if got := SlidingWindowMax(in, 0, compareInts); got != nil {
+		t.Fatalf("Expected maxima: nil\nGot: %v", got)
+	}
+}
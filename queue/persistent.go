@@ -0,0 +1,152 @@
+package queue
+
+import (
+	"iter"
+	"sync"
+)
+
+// Persistent - immutable FIFO queue, Okasaki's real-time queue.
+// Enqueue and Dequeue do not modify Persistent, they return new version
+// sharing elements with origin in O(1) worst-case time: reversal of rear
+// list is spread over operations by lazy rotation and its schedule.
+// Zero value is an empty queue, every version is safe
+// for concurrent reads without locks.
+type Persistent[T any] struct {
+	// front - lazy list of front elements.
+	front *stream[T]
+	// rear - reversed list of back elements.
+	rear *cons[T]
+	// schedule - not yet evaluated suffix of front.
+	schedule *stream[T]
+	size     int
+}
+
+// cons - immutable cell of rear list.
+type cons[T any] struct {
+	value T
+	next  *cons[T]
+}
+
+// stream - lazy list, nil stream is empty.
+// Evaluation is memoized once, so it is safe for concurrent use.
+type stream[T any] struct {
+	once  sync.Once
+	thunk func() *cell[T]
+	cell  *cell[T]
+}
+
+// cell - evaluated element of stream, nil cell is the end of stream.
+type cell[T any] struct {
+	head T
+	tail *stream[T]
+}
+
+// lazy - returns stream, evaluated by thunk on first force.
+func lazy[T any](thunk func() *cell[T]) *stream[T] {
+	return &stream[T]{thunk: thunk}
+}
+
+// ready - returns already evaluated stream.
+func ready[T any](c *cell[T]) *stream[T] {
+	s := &stream[T]{cell: c}
+	s.once.Do(func() {})
+	return s
+}
+
+// force - evaluates stream once, returns its first cell.
+func (s *stream[T]) force() *cell[T] {
+	if s == nil {
+		return nil
+	}
+
+	s.once.Do(func() {
+		s.cell, s.thunk = s.thunk(), nil
+	})
+	return s.cell
+}
+
+// rotate - lazily returns front ++ reversed(rear) ++ acc,
+// where len(rear) == len(front)+1. Every force makes O(1) work.
+func rotate[T any](front *stream[T], rear *cons[T], acc *stream[T]) *stream[T] {
+	return lazy(func() *cell[T] {
+		c := front.force()
+		if c == nil {
+			return &cell[T]{head: rear.value, tail: acc}
+		}
+
+		return &cell[T]{
+			head: c.head,
+			tail: rotate(c.tail, rear.next, ready(&cell[T]{head: rear.value, tail: acc})),
+		}
+	})
+}
+
+// NewPersistent - returns Persistent queue with enqueued elements,
+// where first element becomes front.
+func NewPersistent[T any](elements ...T) Persistent[T] {
+	var p Persistent[T]
+	for _, el := range elements {
+		p = p.Enqueue(el)
+	}
+	return p
+}
+
+// exec - makes one step of scheduled evaluation,
+// or starts new rotation, when schedule is done.
+func exec[T any](front *stream[T], rear *cons[T], schedule *stream[T], size int) Persistent[T] {
+	if c := schedule.force(); c != nil {
+		return Persistent[T]{front: front, rear: rear, schedule: c.tail, size: size}
+	}
+
+	front = rotate(front, rear, nil)
+	return Persistent[T]{front: front, schedule: front, size: size}
+}
+
+func (p Persistent[T]) IsEmpty() bool {
+	return p.size == 0
+}
+
+// Len - returns count of Queue elements.
+func (p Persistent[T]) Len() int {
+	return p.size
+}
+
+func (p Persistent[T]) Front() (T, bool) {
+	c := p.front.force()
+	if c == nil {
+		var zero T
+		return zero, false
+	}
+
+	return c.head, true
+}
+
+// Enqueue - returns new version of queue with element on back.
+func (p Persistent[T]) Enqueue(element T) Persistent[T] {
+	return exec(p.front, &cons[T]{value: element, next: p.rear}, p.schedule, p.size+1)
+}
+
+// Dequeue - returns front element and new version of queue without it,
+// if queue is empty, returns zero value, the same queue and false.
+func (p Persistent[T]) Dequeue() (T, Persistent[T], bool) {
+	c := p.front.force()
+	if c == nil {
+		var zero T
+		return zero, p, false
+	}
+
+	return c.head, exec(c.tail, p.rear, p.schedule, p.size-1), true
+}
+
+// All - returns iterator over queue elements from front to back.
+func (p Persistent[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, q := 0, p; ; i++ {
+			v, next, ok := q.Dequeue()
+			if !ok || !yield(i, v) {
+				return
+			}
+			q = next
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPersistent(t *testing.T) {
+	t.Parallel()
+
+	var empty Persistent[int]
+	if _, same, ok := empty.Dequeue(); ok || !same.IsEmpty() {
+		t.Fatal("Expected empty dequeue\nGot: ok")
+	}
+
+	v1 := NewPersistent(1, 2)
+	v2 := v1.Enqueue(3)
+
+	front, v3, ok := v2.Dequeue()
+	if !ok || front != 1 || v3.Len() != 2 {
+		t.Fatalf("Expected dequeued: 1, size: 2\nGot: %d, %d", front, v3.Len())
+	}
+
+	if front, _ = v1.Front(); front != 1 || v1.Len() != 2 {
+		t.Fatalf("Expected unchanged version: front 1, size 2\nGot: %d, %d", front, v1.Len())
+	}
+
+	var got []int
+	v2.All()(func(_ int, v int) bool {
+		got = append(got, v)
+		return true
+	})
+
+	exp := []int{1, 2, 3}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+}
+
+// TestPersistentModel - compares random operations over random versions
+// with slice model of each version.
+func TestPersistentModel(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(1))
+	versions := []Persistent[int]{{}}
+	models := [][]int{nil}
+
+	for i := 0; i < 2000; i++ {
+		j := rnd.Intn(len(versions))
+		p, m := versions[j], models[j]
+
+		if rnd.Intn(3) > 0 {
+			p = p.Enqueue(i)
+			m = append(append([]int(nil), m...), i)
+		} else {
+			v, next, ok := p.Dequeue()
+			if ok != (len(m) > 0) || ok && v != m[0] {
+				t.Fatalf("Expected dequeue: %v\nGot: %d, %t", m, v, ok)
+			}
+			if ok {
+				p, m = next, m[1:]
+			}
+		}
+
+		if p.Len() != len(m) {
+			t.Fatalf("Expected size: %d\nGot: %d", len(m), p.Len())
+		}
+		versions, models = append(versions, p), append(models, m)
+	}
+}
+
+func TestPersistentConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	p := NewPersistent(1, 2, 3)
+
+	wg := sync.WaitGroup{}
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			q := p.Enqueue(g)
+			for i := 1; i <= 3; i++ {
+				v, next, _ := q.Dequeue()
+				if v != i {
+					t.Errorf("Expected dequeued: %d\nGot: %d", i, v)
+				}
+				q = next
+			}
+			if v, _ := q.Front(); v != g {
+				t.Errorf("Expected front: %d\nGot: %d", g, v)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
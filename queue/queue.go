@@ -1,41 +1,119 @@
 package queue
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
 
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/seqfmt"
+)
+
+var (
+	_ containers.Sequence[any] = (*Queue[any])(nil)
+	_ containers.Container     = (*MonotonicQueue[any])(nil)
+)
+
+// Queue - represents FIFO queue,
+// that holds values of any type.
 type Queue[T any] struct {
-	Qu            []T
-	Capacity, Len int
+	qu []T
+	// capacity - max count of elements, 0 is infinite.
+	capacity int
 }
 
+// String - returns Queue elements from front to back, its Length and cap.
+// Long Queue is truncated, see Format.
 func (q *Queue[T]) String() string {
-	for i := q.Len - 1; i > -1; i-- {
-		fmt.Print(q.Qu[i], " ")
+	return fmt.Sprintf("%v", q)
+}
+
+// Format - implements fmt.Formatter.
+// %v and %s print up to seqfmt.Limit elements, %+v prints all of them,
+// precision (%.5v) sets own limit. Other verbs are applied to every element.
+func (q *Queue[T]) Format(f fmt.State, verb rune) {
+	p := seqfmt.New(f, verb)
+	for _, el := range q.qu {
+		if !p.Add(el) {
+			break
+		}
+	}
+	p.Close(len(q.qu))
+
+	_, _ = fmt.Fprintf(f, ", Length(%v), cap(%v)", len(q.qu), q.capacity)
+}
+
+// MarshalJSON - implements json.Marshaler,
+// returns Queue elements from front to back as JSON array.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	if q.qu == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(q.qu)
+}
+
+// UnmarshalJSON - implements json.Unmarshaler,
+// replaces Queue elements with JSON array, where first element becomes front.
+// Returns error if elements count is over Queue Capacity.
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var qu []T
+	if err := json.Unmarshal(data, &qu); err != nil {
+		return err
 	}
 
-	return fmt.Sprintf(
-		"Length(%v), cap(%v)",
-		q.Len, q.Capacity,
-	)
+	if q.capacity != 0 && len(qu) > q.capacity {
+		return fmt.Errorf("queue: %d elements exceed capacity %d", len(qu), q.capacity)
+	}
+
+	q.qu = qu
+	return nil
+}
+
+// MarshalText - implements encoding.TextMarshaler,
+// encodes Queue elements the same way as MarshalJSON,
+// so the result can be decoded back with UnmarshalText.
+// Use String or Format for human-readable output.
+func (q *Queue[T]) MarshalText() ([]byte, error) {
+	return q.MarshalJSON()
+}
+
+// UnmarshalText - implements encoding.TextUnmarshaler,
+// decodes Queue elements encoded by MarshalText.
+func (q *Queue[T]) UnmarshalText(text []byte) error {
+	return q.UnmarshalJSON(text)
 }
 
 // New provide 0 Capacity to make Queue Capacity infinite
 func New[T any](capacity int) Queue[T] {
-	return Queue[T]{Capacity: capacity}
+	return Queue[T]{capacity: capacity}
 }
 
 func (q *Queue[T]) IsEmpty() bool {
-	return q.Len == 0
+	return len(q.qu) == 0
 }
 
 func (q *Queue[T]) IsFull() bool {
-	if q.Len < q.Capacity || q.Capacity == 0 {
+	if len(q.qu) < q.capacity || q.capacity == 0 {
 		return false
 	}
 	return true
 }
 
+// Len - returns count of Queue elements.
+func (q *Queue[T]) Len() int {
+	return len(q.qu)
+}
+
+// Size - returns count of Queue elements.
+//
+// Deprecated: use Len.
 func (q *Queue[T]) Size() int {
-	return q.Len
+	return q.Len()
+}
+
+// Capacity - returns max count of Queue elements, 0 is infinite.
+func (q *Queue[T]) Capacity() int {
+	return q.capacity
 }
 
 func (q *Queue[T]) Front() (T, bool) {
@@ -44,26 +122,89 @@ func (q *Queue[T]) Front() (T, bool) {
 		return zero, false
 	}
 
-	return q.Qu[0], true
+	return q.qu[0], true
+}
+
+// Back - returns the last enqueued element,
+// if Queue is empty, returns zero value and false.
+func (q *Queue[T]) Back() (T, bool) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	return q.qu[len(q.qu)-1], true
 }
 
 func (q *Queue[T]) Enqueue(element T) bool {
 	if !q.IsFull() {
-		q.Qu = append(q.Qu, element)
-		q.Len++
+		q.qu = append(q.qu, element)
 		return true
 	}
 	return false
 }
 
+// Add - enqueues element, implements containers.Collection.
+func (q *Queue[T]) Add(element T) bool {
+	return q.Enqueue(element)
+}
+
 func (q *Queue[T]) Dequeue() (T, bool) {
 	if q.IsEmpty() {
 		var zero T
 		return zero, false
 	}
 
-	element := q.Qu[0]
-	q.Qu = (q.Qu)[1:]
-	q.Len--
+	var zero T
+	element := q.qu[0]
+	q.qu[0] = zero
+	q.qu = (q.qu)[1:]
 	return element, true
 }
+
+// ToSlice - returns copy of Queue elements from front to back.
+func (q *Queue[T]) ToSlice() []T {
+	return append([]T(nil), q.qu...)
+}
+
+// All - returns iterator over Queue elements from front to back.
+// Queue must not be modified during iteration.
+func (q *Queue[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, el := range q.qu {
+			if !yield(i, el) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns iterator over Queue elements from back to front,
+// with the same indexes as All.
+// Queue must not be modified during iteration.
+func (q *Queue[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(q.qu) - 1; i >= 0; i-- {
+			if !yield(i, q.qu[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values - returns iterator over Queue elements from front to back.
+// Queue must not be modified during iteration.
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range q.qu {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Clear - removes all elements from Queue.
+func (q *Queue[T]) Clear() {
+	q.qu = nil
+}
@@ -0,0 +1,139 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/internal/seqfmt"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	q := New[int](0)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	exp := "[1 2 3], Length(3), cap(0)"
+	if got := q.String(); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+
+	if got := fmt.Sprint(&q); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	q := New[int](0)
+	for i := 0; i < seqfmt.Limit+2; i++ {
+		q.Enqueue(i)
+	}
+
+	if got := fmt.Sprintf("%v", &q); !strings.Contains(got, "... +2 more]") {
+		t.Fatalf("Expected truncated elements\nGot: %s", got)
+	}
+
+	if got := fmt.Sprintf("%+v", &q); strings.Contains(got, "more") {
+		t.Fatalf("Expected all elements\nGot: %s", got)
+	}
+
+	exp := "[0 ... +17 more], Length(18), cap(0)"
+	if got := fmt.Sprintf("%.1v", &q); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	q := New[string](0)
+	q.Enqueue("a")
+	q.Enqueue("b")
+
+	data, err := json.Marshal(&q)
+	if err != nil || string(data) != `["a","b"]` {
+		t.Fatalf(`Expected json: ["a","b"]`+"\nGot: %s, %v", data, err)
+	}
+
+	res := New[string](0)
+	if err = json.Unmarshal(data, &res); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(q, res) {
+		t.Fatalf("Expected queue: %v\nGot: %v", &q, &res)
+	}
+
+	text, err := q.MarshalText()
+	if err != nil || string(text) != string(data) {
+		t.Fatalf("Expected text: %s\nGot: %s, %v", data, text, err)
+	}
+
+	res = New[string](0)
+	if err = res.UnmarshalText(text); err != nil || !reflect.DeepEqual(q, res) {
+		t.Fatalf("Expected queue: %v\nGot: %v, %v", &q, &res, err)
+	}
+
+	small := New[string](1)
+	if err = small.UnmarshalText(text); err == nil {
+		t.Fatal("Expected capacity error\nGot: nil")
+	}
+}
+
+func TestIterators(t *testing.T) {
+	t.Parallel()
+
+	q := New[int](0)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	var got []int
+	for i, v := range q.Backward() {
+		if i == 0 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp := []int{3, 2}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+
+	got = got[:0]
+	for v := range q.Values() {
+		got = append(got, v)
+	}
+	for _, v := range q.All() {
+		if v == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp = []int{1, 2, 3, 1}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+
+	got = got[:0]
+	for _, v := range NewPersistent(1, 2, 3).All() {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp = []int{1, 2}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+}
@@ -0,0 +1,123 @@
+package stack
+
+import (
+	"sort"
+	"sync"
+	"testing"
+)
+
+var (
+	_ Interface[int] = (*Stack[int])(nil)
+	_ Interface[int] = (*SyncStack[int])(nil)
+	_ Interface[int] = (*LockFreeStack[int])(nil)
+)
+
+const (
+	goroutines = 8
+	perRoutine = 1000
+)
+
+func TestConcurrent(t *testing.T) {
+	t.Parallel()
+
+	stacks := map[string]func() Interface[int]{
+		"SyncStack":     func() Interface[int] { return NewSync[int]() },
+		"LockFreeStack": func() Interface[int] { return NewLockFree[int]() },
+	}
+
+	for name, newStack := range stacks {
+		newStack := newStack
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newStack()
+			popped := make(chan int, goroutines*perRoutine)
+
+			wg := sync.WaitGroup{}
+			for g := 0; g < goroutines; g++ {
+				wg.Add(1)
+				go func(g int) {
+					defer wg.Done()
+					for i := 0; i < perRoutine; i++ {
+						s.Push(g*perRoutine + i)
+						if i%2 == 0 {
+							if v, ok := s.Pop(); ok {
+								popped <- v
+							}
+						}
+					}
+				}(g)
+			}
+			wg.Wait()
+
+			for v, ok := s.Pop(); ok; v, ok = s.Pop() {
+				popped <- v
+			}
+			close(popped)
+
+			var got []int
+			for v := range popped {
+				got = append(got, v)
+			}
+			sort.Ints(got)
+
+			if len(got) != goroutines*perRoutine {
+				t.Fatalf("Expected elements: %d\nGot: %d", goroutines*perRoutine, len(got))
+			}
+			for i, v := range got {
+				if v != i {
+					t.Fatalf("Expected element: %d\nGot: %d", i, v)
+				}
+			}
+
+			if !s.IsEmpty() || s.Len() != 0 {
+				t.Fatalf("Expected empty stack\nGot size: %d", s.Len())
+			}
+		})
+	}
+}
+
+func TestLockFreeClear(t *testing.T) {
+	t.Parallel()
+
+	var s LockFreeStack[int]
+	s.Push(1)
+	s.Push(2)
+
+	if v, ok := s.Top(); !ok || v != 2 || s.Len() != 2 {
+		t.Fatalf("Expected top: 2, size: 2\nGot: %d, %d", v, s.Len())
+	}
+
+	s.Clear()
+	if _, ok := s.Pop(); ok || s.Len() != 0 {
+		t.Fatalf("Expected empty stack\nGot size: %d", s.Len())
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	s := New[int]()
+	for i := 0; i < b.N; i++ {
+		s.Push(i)
+		s.Pop()
+	}
+}
+
+func BenchmarkSyncStack(b *testing.B) {
+	s := NewSync[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
+
+func BenchmarkLockFreeStack(b *testing.B) {
+	s := NewLockFree[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			s.Push(i)
+			s.Pop()
+		}
+	})
+}
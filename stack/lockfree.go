@@ -0,0 +1,129 @@
+package stack
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+// LockFreeStack - lock-free Treiber stack, safe for concurrent use.
+// Zero value is an empty infinite stack.
+//
+// Every Push allocates new node and nodes are never reused,
+// so pointer, that CAS compares, can not be recycled by another
+// goroutine while it's held: garbage collector keeps it alive.
+// That excludes ABA problem without tagged pointers.
+type LockFreeStack[T any] struct {
+	head atomic.Pointer[lfNode[T]]
+	size atomic.Int64
+}
+
+// lfNode - immutable after publication node of LockFreeStack.
+type lfNode[T any] struct {
+	value T
+	next  *lfNode[T]
+}
+
+// NewLockFree - returns new LockFreeStack.
+func NewLockFree[T any]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
+
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
+
+// Len - returns count of Stack elements.
+// Under concurrent modification result is approximate.
+func (s *LockFreeStack[T]) Len() int {
+	if size := s.size.Load(); size > 0 {
+		return int(size)
+	}
+	return 0
+}
+
+func (s *LockFreeStack[T]) Top() (T, bool) {
+	head := s.head.Load()
+	if head == nil {
+		var zero T
+		return zero, false
+	}
+
+	return head.value, true
+}
+
+// Push - pushes element, always returns true, LockFreeStack is infinite.
+func (s *LockFreeStack[T]) Push(element T) bool {
+	node := &lfNode[T]{value: element}
+	for {
+		node.next = s.head.Load()
+		if s.head.CompareAndSwap(node.next, node) {
+			s.size.Add(1)
+			return true
+		}
+	}
+}
+
+// Add - pushes element, implements containers.Collection.
+func (s *LockFreeStack[T]) Add(element T) bool {
+	return s.Push(element)
+}
+
+func (s *LockFreeStack[T]) Pop() (T, bool) {
+	for {
+		head := s.head.Load()
+		if head == nil {
+			var zero T
+			return zero, false
+		}
+
+		if s.head.CompareAndSwap(head, head.next) {
+			s.size.Add(-1)
+			return head.value, true
+		}
+	}
+}
+
+// Clear - atomically removes all elements from Stack.
+func (s *LockFreeStack[T]) Clear() {
+	for {
+		head := s.head.Load()
+		if s.head.CompareAndSwap(head, nil) {
+			n := 0
+			for ; head != nil; head = head.next {
+				n++
+			}
+			s.size.Add(-int64(n))
+			return
+		}
+	}
+}
+
+// ToSlice - returns snapshot of Stack elements from bottom to top.
+func (s *LockFreeStack[T]) ToSlice() []T {
+	var elements []T
+	for node := s.head.Load(); node != nil; node = node.next {
+		elements = append(elements, node.value)
+	}
+
+	for i, j := 0, len(elements)-1; i < j; i, j = i+1, j-1 {
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+	return elements
+}
+
+// All - returns iterator over Stack elements in LIFO order,
+// where index 0 is the top element. Nodes are immutable,
+// so iteration sees the Stack at the moment of call.
+func (s *LockFreeStack[T]) All() iter.Seq2[int, T] {
+	head := s.head.Load()
+
+	return func(yield func(int, T) bool) {
+		i := 0
+		for node := head; node != nil; node = node.next {
+			if !yield(i, node.value) {
+				return
+			}
+			i++
+		}
+	}
+}
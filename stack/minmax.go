@@ -0,0 +1,90 @@
+package stack
+
+// MinMaxStack - Stack, that returns its min and max elements in O(1).
+type MinMaxStack[T any] struct {
+	s Stack[minMaxEntry[T]]
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b T) int
+}
+
+// minMaxEntry - pushed element with min and max of elements below and itself.
+type minMaxEntry[T any] struct {
+	value, min, max T
+}
+
+// NewMinMax - returns new MinMaxStack, that orders elements with compare,
+// configured with provided options.
+func NewMinMax[T any](compare func(a, b T) int, opts ...Option) *MinMaxStack[T] {
+	return &MinMaxStack[T]{s: New[minMaxEntry[T]](opts...), compare: compare}
+}
+
+func (m *MinMaxStack[T]) IsEmpty() bool {
+	return m.s.IsEmpty()
+}
+
+func (m *MinMaxStack[T]) IsFull() bool {
+	return m.s.IsFull()
+}
+
+// Len - returns count of Stack elements.
+func (m *MinMaxStack[T]) Len() int {
+	return m.s.Len()
+}
+
+func (m *MinMaxStack[T]) Top() (T, bool) {
+	e, ok := m.s.Top()
+	return e.value, ok
+}
+
+func (m *MinMaxStack[T]) Push(element T) bool {
+	e := minMaxEntry[T]{value: element, min: element, max: element}
+	if top, ok := m.s.Top(); ok {
+		if m.compare(top.min, element) < 0 {
+			e.min = top.min
+		}
+		if m.compare(top.max, element) > 0 {
+			e.max = top.max
+		}
+	}
+
+	return m.s.Push(e)
+}
+
+// Add - pushes element, implements containers.Collection.
+func (m *MinMaxStack[T]) Add(element T) bool {
+	return m.Push(element)
+}
+
+func (m *MinMaxStack[T]) Pop() (T, bool) {
+	e, ok := m.s.Pop()
+	return e.value, ok
+}
+
+// GetMin - returns min element of Stack,
+// if Stack is empty, returns zero value and false.
+func (m *MinMaxStack[T]) GetMin() (T, bool) {
+	e, ok := m.s.Top()
+	return e.min, ok
+}
+
+// GetMax - returns max element of Stack,
+// if Stack is empty, returns zero value and false.
+func (m *MinMaxStack[T]) GetMax() (T, bool) {
+	e, ok := m.s.Top()
+	return e.max, ok
+}
+
+// Clear - removes all elements from Stack.
+func (m *MinMaxStack[T]) Clear() {
+	m.s.Clear()
+}
+
+// ToSlice - returns copy of Stack elements from bottom to top.
+func (m *MinMaxStack[T]) ToSlice() []T {
+	elements := make([]T, 0, m.s.Len())
+	for _, e := range m.s.st {
+		elements = append(elements, e.value)
+	}
+	return elements
+}
@@ -0,0 +1,45 @@
+package stack
+
+import "testing"
+
+func compareInts(a, b int) int {
+	return a - b
+}
+
+func TestMinMaxStack(t *testing.T) {
+	t.Parallel()
+
+	s := NewMinMax(compareInts)
+	if _, ok := s.GetMin(); ok {
+		t.Fatal("Expected empty min\nGot: ok")
+	}
+
+	steps := []struct{ push, min, max int }{
+		{5, 5, 5},
+		{3, 3, 5},
+		{7, 3, 7},
+		{3, 3, 7},
+		{1, 1, 7},
+	}
+
+	for _, st := range steps {
+		s.Push(st.push)
+		min, _ := s.GetMin()
+		max, _ := s.GetMax()
+		if min != st.min || max != st.max {
+			t.Fatalf("Expected min, max: %d, %d\nGot: %d, %d", st.min, st.max, min, max)
+		}
+	}
+
+	for i := len(steps) - 1; i > 0; i-- {
+		if v, _ := s.Pop(); v != steps[i].push {
+			t.Fatalf("Expected popped: %d\nGot: %d", steps[i].push, v)
+		}
+
+		min, _ := s.GetMin()
+		max, _ := s.GetMax()
+		if min != steps[i-1].min || max != steps[i-1].max {
+			t.Fatalf("Expected min, max: %d, %d\nGot: %d, %d", steps[i-1].min, steps[i-1].max, min, max)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package stack
+
+import "iter"
+
+// Persistent - immutable LIFO stack, based on cons list.
+// Push and Pop do not modify Persistent, they return new version
+// sharing elements with origin in O(1).
+// Zero value is an empty stack, every version is safe
+// for concurrent reads without locks.
+type Persistent[T any] struct {
+	top  *cons[T]
+	size int
+}
+
+// cons - immutable cell of Persistent stack.
+type cons[T any] struct {
+	value T
+	next  *cons[T]
+}
+
+// NewPersistent - returns Persistent stack with pushed elements,
+// where last element becomes top.
+func NewPersistent[T any](elements ...T) Persistent[T] {
+	var p Persistent[T]
+	for _, el := range elements {
+		p = p.Push(el)
+	}
+	return p
+}
+
+func (p Persistent[T]) IsEmpty() bool {
+	return p.size == 0
+}
+
+// Len - returns count of Stack elements.
+func (p Persistent[T]) Len() int {
+	return p.size
+}
+
+func (p Persistent[T]) Top() (T, bool) {
+	if p.top == nil {
+		var zero T
+		return zero, false
+	}
+
+	return p.top.value, true
+}
+
+// Push - returns new version of stack with element on top.
+func (p Persistent[T]) Push(element T) Persistent[T] {
+	return Persistent[T]{
+		top:  &cons[T]{value: element, next: p.top},
+		size: p.size + 1,
+	}
+}
+
+// Pop - returns top element and new version of stack without it,
+// if stack is empty, returns zero value, the same stack and false.
+func (p Persistent[T]) Pop() (T, Persistent[T], bool) {
+	if p.top == nil {
+		var zero T
+		return zero, p, false
+	}
+
+	return p.top.value, Persistent[T]{top: p.top.next, size: p.size - 1}, true
+}
+
+// All - returns iterator over stack elements in LIFO order,
+// where index 0 is the top element.
+func (p Persistent[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for c := p.top; c != nil; c = c.next {
+			if !yield(i, c.value) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package stack
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPersistent(t *testing.T) {
+	t.Parallel()
+
+	var empty Persistent[int]
+	if _, same, ok := empty.Pop(); ok || !same.IsEmpty() {
+		t.Fatal("Expected empty pop\nGot: ok")
+	}
+
+	v1 := NewPersistent(1, 2)
+	v2 := v1.Push(3)
+
+	top, v3, ok := v2.Pop()
+	if !ok || top != 3 || v3.Len() != 2 {
+		t.Fatalf("Expected popped: 3, size: 2\nGot: %d, %d", top, v3.Len())
+	}
+
+	if top, _ = v1.Top(); top != 2 || v1.Len() != 2 {
+		t.Fatalf("Expected unchanged version: top 2, size 2\nGot: %d, %d", top, v1.Len())
+	}
+
+	var got []int
+	v2.All()(func(_ int, v int) bool {
+		got = append(got, v)
+		return true
+	})
+
+	exp := []int{3, 2, 1}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestPersistentConcurrentReads(t *testing.T) {
+	t.Parallel()
+
+	p := NewPersistent(1, 2, 3)
+
+	wg := sync.WaitGroup{}
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			if v, _, _ := p.Push(g).Pop(); v != g {
+				t.Errorf("Expected popped: %d\nGot: %d", g, v)
+			}
+			if v, _ := p.Top(); v != 3 {
+				t.Errorf("Expected top: 3\nGot: %d", v)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
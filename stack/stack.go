@@ -1,65 +1,351 @@
 package stack
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
 
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/seqfmt"
+)
+
+var (
+	_ containers.Collection[any] = (*Stack[any])(nil)
+	_ containers.Collection[any] = (*SyncStack[any])(nil)
+	_ containers.Collection[any] = (*LockFreeStack[any])(nil)
+	_ containers.Collection[any] = (*MinMaxStack[any])(nil)
+)
+
+// minShrinkCap - minimal cap of underlying slice, that will be shrunk.
+const minShrinkCap = 64
+
+// Interface - common behaviour of Stack, SyncStack and LockFreeStack.
+type Interface[T any] interface {
+	Push(element T) bool
+	Pop() (T, bool)
+	Top() (T, bool)
+	Len() int
+	IsEmpty() bool
+}
+
+// Stack - represents LIFO stack,
+// that holds values of any type.
 type Stack[T any] struct {
-	St            []T
-	Capacity, Len int
+	st []T
+	// capacity - max count of elements, 0 is infinite.
+	capacity int
+	// shrink - release memory of underlying slice, when Stack drains.
+	shrink bool
+	// prealloc - requested memory, that is kept on shrinking.
+	prealloc int
+}
+
+// Option - configures Stack, provided in New.
+type Option func(*options)
+
+type options struct {
+	capacity, prealloc int
+	noShrink           bool
+}
+
+// WithCapacity - sets max count of Stack elements,
+// 0 makes Stack Capacity infinite, negative capacity is treated as 0.
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		o.capacity = max(capacity, 0)
+	}
 }
 
+// WithPrealloc - allocates memory for n elements in advance,
+// Stack never shrinks below it.
+func WithPrealloc(n int) Option {
+	return func(o *options) {
+		o.prealloc = n
+	}
+}
+
+// WithoutShrink - keeps allocated memory, when Stack drains.
+func WithoutShrink() Option {
+	return func(o *options) {
+		o.noShrink = true
+	}
+}
+
+// New - returns new Stack, configured with provided options.
+// Without options Stack Capacity is infinite.
+func New[T any](opts ...Option) Stack[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s := Stack[T]{capacity: o.capacity, shrink: !o.noShrink, prealloc: max(o.prealloc, 0)}
+	if s.prealloc > 0 {
+		s.st = make([]T, 0, s.prealloc)
+	}
+	return s
+}
+
+// String - returns Stack elements from bottom to top, its Length and cap.
+// Long Stack is truncated, see Format.
 func (s *Stack[T]) String() string {
-	return fmt.Sprintf(
-		"%v, Length(%v), cap(%v)",
-		s.St, s.Len, s.Capacity,
-	)
+	return fmt.Sprintf("%v", s)
 }
 
-// New provide 0 Capacity to make Stack Capacity infinite
-func New[T any](capacity int) Stack[T] {
-	return Stack[T]{Capacity: capacity}
+// Format - implements fmt.Formatter.
+// %v and %s print up to seqfmt.Limit elements, %+v prints all of them,
+// precision (%.5v) sets own limit. Other verbs are applied to every element.
+func (s *Stack[T]) Format(f fmt.State, verb rune) {
+	p := seqfmt.New(f, verb)
+	for _, el := range s.st {
+		if !p.Add(el) {
+			break
+		}
+	}
+	p.Close(len(s.st))
+
+	_, _ = fmt.Fprintf(f, ", Length(%v), cap(%v)", len(s.st), s.capacity)
+}
+
+// MarshalJSON - implements json.Marshaler,
+// returns Stack elements from bottom to top as JSON array.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	if s.st == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.st)
+}
+
+// UnmarshalJSON - implements json.Unmarshaler,
+// replaces Stack elements with JSON array, where last element becomes top.
+// Returns error if elements count is over Stack Capacity.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	var st []T
+	if err := json.Unmarshal(data, &st); err != nil {
+		return err
+	}
+
+	if s.capacity != 0 && len(st) > s.capacity {
+		return fmt.Errorf("stack: %d elements exceed capacity %d", len(st), s.capacity)
+	}
+
+	s.st = make([]T, len(st), max(len(st), s.prealloc))
+	copy(s.st, st)
+	return nil
+}
+
+// MarshalText - implements encoding.TextMarshaler,
+// encodes Stack elements the same way as MarshalJSON,
+// so the result can be decoded back with UnmarshalText.
+// Use String or Format for human-readable output.
+func (s *Stack[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText - implements encoding.TextUnmarshaler,
+// decodes Stack elements encoded by MarshalText.
+func (s *Stack[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
 }
 
 func (s *Stack[T]) IsEmpty() bool {
-	return s.Len == 0
+	return len(s.st) == 0
 }
 
 func (s *Stack[T]) IsFull() bool {
-	if s.Len < s.Capacity || s.Capacity == 0 {
+	if len(s.st) < s.capacity || s.capacity == 0 {
 		return false
 	}
 	return true
 }
 
+// Len - returns count of Stack elements.
+func (s *Stack[T]) Len() int {
+	return len(s.st)
+}
+
+// Size - returns count of Stack elements.
+//
+// Deprecated: use Len.
 func (s *Stack[T]) Size() int {
-	return s.Len
+	return s.Len()
+}
+
+// Capacity - returns max count of Stack elements, 0 is infinite.
+func (s *Stack[T]) Capacity() int {
+	return s.capacity
 }
 
 func (s *Stack[T]) Top() (T, bool) {
-	if s.IsEmpty() {
+	return s.Peek(0)
+}
+
+// Peek - returns n-th element from top, without removing it.
+// Peek(0) is the same as Top.
+func (s *Stack[T]) Peek(n int) (T, bool) {
+	if n < 0 || n >= len(s.st) {
 		var zero T
 		return zero, false
 	}
 
-	return s.St[len(s.St)-1], true
+	return s.st[len(s.st)-1-n], true
 }
 
 func (s *Stack[T]) Push(element T) bool {
 	if !s.IsFull() {
-		s.St = append(s.St, element)
-		s.Len++
+		s.st = append(s.st, element)
 		return true
 	}
 	return false
 }
 
+// PushN - pushes elements in provided order, until Stack is full.
+// Returns count of pushed elements.
+func (s *Stack[T]) PushN(elements ...T) int {
+	n := len(elements)
+	if s.capacity != 0 && n > s.capacity-len(s.st) {
+		n = s.capacity - len(s.st)
+	}
+
+	s.st = append(s.st, elements[:n]...)
+	return n
+}
+
+// Add - pushes element, implements containers.Collection.
+func (s *Stack[T]) Add(element T) bool {
+	return s.Push(element)
+}
+
 func (s *Stack[T]) Pop() (T, bool) {
 	if s.IsEmpty() {
 		var zero T
 		return zero, false
 	}
 
-	element := s.St[len(s.St)-1]
-	s.St = (s.St)[:len(s.St)-1]
-	s.Len--
+	element := s.st[len(s.st)-1]
+	s.truncate(len(s.st) - 1)
 	return element, true
 }
+
+// PopN - pops up to n elements, returns them in LIFO order.
+func (s *Stack[T]) PopN(n int) []T {
+	if n > len(s.st) {
+		n = len(s.st)
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	elements := make([]T, n)
+	for i := range elements {
+		elements[i] = s.st[len(s.st)-1-i]
+	}
+
+	s.truncate(len(s.st) - n)
+	return elements
+}
+
+// Clear - removes all elements from Stack.
+func (s *Stack[T]) Clear() {
+	s.truncate(0)
+}
+
+// ToSlice - returns copy of Stack elements from bottom to top,
+// so pushing them in order restores the Stack.
+func (s *Stack[T]) ToSlice() []T {
+	return append([]T(nil), s.st...)
+}
+
+// Clone - returns copy of Stack, that does not share memory with origin.
+func (s *Stack[T]) Clone() Stack[T] {
+	clone := *s
+	clone.st = make([]T, len(s.st), max(len(s.st), s.prealloc))
+	copy(clone.st, s.st)
+	return clone
+}
+
+// All - returns iterator over Stack elements in LIFO order,
+// where index 0 is the top element.
+// Stack must not be modified during iteration.
+func (s *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s.st) - 1; i >= 0; i-- {
+			if !yield(len(s.st)-1-i, s.st[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns iterator over Stack elements in FIFO order,
+// from bottom to top, with the same indexes as All.
+// Stack must not be modified during iteration.
+func (s *Stack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, el := range s.st {
+			if !yield(len(s.st)-1-i, el) {
+				return
+			}
+		}
+	}
+}
+
+// Values - returns iterator over Stack elements in LIFO order.
+// Stack must not be modified during iteration.
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range s.All() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Iter - returns Iterator over Stack elements in LIFO order.
+// Stack must not be modified during iteration.
+func (s *Stack[T]) Iter() *Iterator[T] {
+	return &Iterator[T]{st: s.st, i: len(s.st)}
+}
+
+// Iterator - iterates over Stack elements from top to bottom.
+type Iterator[T any] struct {
+	st []T
+	i  int
+}
+
+// Next - advances Iterator to the next element,
+// returns false when there are no more elements.
+func (it *Iterator[T]) Next() bool {
+	if it.i <= 0 {
+		return false
+	}
+	it.i--
+	return true
+}
+
+// Value - returns current element of Iterator.
+func (it *Iterator[T]) Value() T {
+	return it.st[it.i]
+}
+
+// truncate - cuts Stack to n elements, releasing references to removed ones.
+// If shrinking is on and Stack is drained to quarter of its memory,
+// underlying slice is reallocated, but not below preallocated size.
+func (s *Stack[T]) truncate(n int) {
+	var zero T
+	for i := n; i < len(s.st); i++ {
+		s.st[i] = zero
+	}
+	s.st = s.st[:n]
+
+	if !s.shrink || cap(s.st) < minShrinkCap || n > cap(s.st)/4 || cap(s.st) <= s.prealloc {
+		return
+	}
+
+	if n == 0 && s.prealloc == 0 {
+		s.st = nil
+		return
+	}
+	s.st = append(make([]T, 0, max(cap(s.st)/2, s.prealloc)), s.st...)
+}
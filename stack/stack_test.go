@@ -0,0 +1,327 @@
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/internal/seqfmt"
+)
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	exp := "[1 2 3], Length(3), cap(0)"
+	if got := s.String(); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+
+	if got := fmt.Sprint(&s); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	for i := 0; i < seqfmt.Limit+2; i++ {
+		s.Push(i)
+	}
+
+	if got := fmt.Sprintf("%v", &s); !strings.Contains(got, "... +2 more]") {
+		t.Fatalf("Expected truncated elements\nGot: %s", got)
+	}
+
+	if got := fmt.Sprintf("%+v", &s); strings.Contains(got, "more") {
+		t.Fatalf("Expected all elements\nGot: %s", got)
+	}
+
+	exp := "[0 1 ... +16 more], Length(18), cap(0)"
+	if got := fmt.Sprintf("%.2v", &s); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+
+	st := New[string](WithCapacity(2))
+	st.Push("a")
+	st.Push("b")
+
+	exp = `["a" "b"], Length(2), cap(2)`
+	if got := fmt.Sprintf("%q", &st); got != exp {
+		t.Fatalf("Expected string: %s\nGot: %s", exp, got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	data, err := json.Marshal(&s)
+	if err != nil || string(data) != "[]" {
+		t.Fatalf("Expected json: []\nGot: %s, %v", data, err)
+	}
+
+	s.Push(1)
+	s.Push(2)
+
+	data, err = json.Marshal(&s)
+	if err != nil || string(data) != "[1,2]" {
+		t.Fatalf("Expected json: [1,2]\nGot: %s, %v", data, err)
+	}
+
+	res := New[int]()
+	if err = json.Unmarshal(data, &res); err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+
+	if !reflect.DeepEqual(s, res) {
+		t.Fatalf("Expected stack: %v\nGot: %v", &s, &res)
+	}
+
+	small := New[int](WithCapacity(1))
+	if err = json.Unmarshal(data, &small); err == nil {
+		t.Fatal("Expected capacity error\nGot: nil")
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	s := New[string]()
+	s.Push("a")
+	s.Push("b c")
+
+	text, err := s.MarshalText()
+	if err != nil || string(text) != `["a","b c"]` {
+		t.Fatalf(`Expected text: ["a","b c"]`+"\nGot: %s, %v", text, err)
+	}
+
+	res := New[string]()
+	if err = res.UnmarshalText(text); err != nil || !reflect.DeepEqual(s, res) {
+		t.Fatalf("Expected stack: %v\nGot: %v, %v", &s, &res, err)
+	}
+
+	if err = res.UnmarshalText([]byte("[a b c], Length(3), cap(0)")); err == nil {
+		t.Fatal("Expected error for display text\nGot: nil")
+	}
+}
+
+func TestPeek(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	if _, ok := s.Peek(0); ok {
+		t.Fatal("Expected empty peek\nGot: ok")
+	}
+
+	s.PushN(1, 2, 3)
+	for n, exp := range []int{3, 2, 1} {
+		if v, ok := s.Peek(n); !ok || v != exp {
+			t.Fatalf("Expected peek(%d): %d\nGot: %d, %t", n, exp, v, ok)
+		}
+	}
+
+	if _, ok := s.Peek(3); ok {
+		t.Fatal("Expected out of range peek\nGot: ok")
+	}
+}
+
+func TestPushNPopN(t *testing.T) {
+	t.Parallel()
+
+	s := New[int](WithCapacity(3))
+	if n := s.PushN(1, 2, 3, 4); n != 3 || !s.IsFull() {
+		t.Fatalf("Expected pushed: 3, full stack\nGot: %d, %v", n, &s)
+	}
+
+	exp := []int{3, 2}
+	if got := s.PopN(2); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected popped: %v\nGot: %v", exp, got)
+	}
+
+	exp = []int{1}
+	if got := s.PopN(5); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected popped: %v\nGot: %v", exp, got)
+	}
+
+	if got := s.PopN(1); got != nil {
+		t.Fatalf("Expected popped: nil\nGot: %v", got)
+	}
+}
+
+func TestIteration(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	s.PushN(1, 2, 3)
+
+	var got []int
+	s.All()(func(i, v int) bool {
+		if i != len(got) {
+			t.Fatalf("Expected index: %d\nGot: %d", len(got), i)
+		}
+		got = append(got, v)
+		return v != 2
+	})
+
+	exp := []int{3, 2}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+
+	got = got[:0]
+	for it := s.Iter(); it.Next(); {
+		got = append(got, it.Value())
+	}
+
+	exp = []int{3, 2, 1}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestCloneClear(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	s.PushN(1, 2)
+
+	clone := s.Clone()
+	s.Clear()
+	s.Push(3)
+
+	if v, _ := clone.Top(); clone.Len() != 2 || v != 2 {
+		t.Fatalf("Expected independent clone\nGot: %v", &clone)
+	}
+
+	if v, _ := s.Top(); s.Len() != 1 || v != 3 {
+		t.Fatalf("Expected stack [3]\nGot: %v", &s)
+	}
+}
+
+func TestShrink(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	for i := 0; i < minShrinkCap*4; i++ {
+		s.Push(i)
+	}
+	grown := cap(s.st)
+
+	s.PopN(s.Len() - minShrinkCap/2)
+	if cap(s.st) >= grown {
+		t.Fatalf("Expected cap less than: %d\nGot: %d", grown, cap(s.st))
+	}
+
+	kept := New[int](WithoutShrink(), WithPrealloc(minShrinkCap*4))
+	kept.PushN(1, 2, 3)
+	kept.PopN(3)
+	if cap(kept.st) != minShrinkCap*4 {
+		t.Fatalf("Expected cap: %d\nGot: %d", minShrinkCap*4, cap(kept.st))
+	}
+
+	prealloc := New[int](WithPrealloc(minShrinkCap * 4))
+	for i := 0; i < minShrinkCap*16; i++ {
+		prealloc.Push(i)
+	}
+	for !prealloc.IsEmpty() {
+		prealloc.Pop()
+		if cap(prealloc.st) < minShrinkCap*4 {
+			t.Fatalf("Expected cap at least: %d\nGot: %d", minShrinkCap*4, cap(prealloc.st))
+		}
+	}
+	if cap(prealloc.st) != minShrinkCap*4 {
+		t.Fatalf("Expected cap: %d\nGot: %d", minShrinkCap*4, cap(prealloc.st))
+	}
+}
+
+func TestPreallocKept(t *testing.T) {
+	t.Parallel()
+
+	s := New[int](WithPrealloc(minShrinkCap))
+	s.PushN(1, 2, 3)
+
+	clone := s.Clone()
+	if cap(clone.st) != minShrinkCap || !reflect.DeepEqual(clone.ToSlice(), s.ToSlice()) {
+		t.Fatalf("Expected clone with cap: %d\nGot: %d, %v", minShrinkCap, cap(clone.st), &clone)
+	}
+
+	if err := json.Unmarshal([]byte("[4,5]"), &s); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []int{4, 5}; cap(s.st) != minShrinkCap || !reflect.DeepEqual(s.ToSlice(), exp) {
+		t.Fatalf("Expected %v with cap: %d\nGot: %v, %d", exp, minShrinkCap, s.ToSlice(), cap(s.st))
+	}
+}
+
+func TestNegativeOptions(t *testing.T) {
+	t.Parallel()
+
+	s := New[int](WithCapacity(-1), WithPrealloc(-1))
+	if s.Capacity() != 0 {
+		t.Fatalf("Expected capacity: 0\nGot: %d", s.Capacity())
+	}
+	if n := s.PushN(1, 2, 3); n != 3 || s.Len() != 3 {
+		t.Fatalf("Expected pushed: 3\nGot: %d", n)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	t.Parallel()
+
+	s := New[int]()
+	s.PushN(1, 2, 3)
+
+	var got []int
+	for i, v := range s.Backward() {
+		if v != s.st[len(s.st)-1-i] {
+			t.Fatalf("Expected element at %d: %d\nGot: %d", i, s.st[len(s.st)-1-i], v)
+		}
+		got = append(got, v)
+	}
+
+	exp := []int{1, 2, 3}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+
+	got = got[:0]
+	for v := range s.Values() {
+		if v == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	exp = []int{3}
+	if !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected elements: %v\nGot: %v", exp, got)
+	}
+
+	syncStack, lockFree := NewSync[int](), NewLockFree[int]()
+	syncStack.PushN(1, 2, 3)
+	lockFree.Push(1)
+	lockFree.Push(2)
+
+	for _, v := range syncStack.All() {
+		syncStack.Push(v) // snapshot iteration allows modification
+		break
+	}
+	for i, v := range lockFree.All() {
+		if i != 0 || v != 2 {
+			t.Fatalf("Expected top: 2\nGot: %d at %d", v, i)
+		}
+		break
+	}
+
+	if syncStack.Len() != 4 {
+		t.Fatalf("Expected length: 4\nGot: %d", syncStack.Len())
+	}
+}
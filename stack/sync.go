@@ -0,0 +1,117 @@
+package stack
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncStack - concurrent-safety Stack, guarded by mutex.
+type SyncStack[T any] struct {
+	mu sync.RWMutex
+	s  Stack[T]
+}
+
+// NewSync - returns new SyncStack, configured with provided options.
+func NewSync[T any](opts ...Option) *SyncStack[T] {
+	return &SyncStack[T]{s: New[T](opts...)}
+}
+
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.IsEmpty()
+}
+
+func (s *SyncStack[T]) IsFull() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.IsFull()
+}
+
+// Len - returns count of Stack elements.
+func (s *SyncStack[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.Len()
+}
+
+func (s *SyncStack[T]) Top() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.Top()
+}
+
+// Peek - returns n-th element from top, without removing it.
+func (s *SyncStack[T]) Peek(n int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.Peek(n)
+}
+
+func (s *SyncStack[T]) Push(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.s.Push(element)
+}
+
+// PushN - atomically pushes elements in provided order, until Stack is full.
+// Returns count of pushed elements.
+func (s *SyncStack[T]) PushN(elements ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.s.PushN(elements...)
+}
+
+// Add - pushes element, implements containers.Collection.
+func (s *SyncStack[T]) Add(element T) bool {
+	return s.Push(element)
+}
+
+func (s *SyncStack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.s.Pop()
+}
+
+// PopN - atomically pops up to n elements, returns them in LIFO order.
+func (s *SyncStack[T]) PopN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.s.PopN(n)
+}
+
+// ToSlice - returns copy of Stack elements from bottom to top.
+func (s *SyncStack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.s.ToSlice()
+}
+
+// Clear - removes all elements from Stack.
+func (s *SyncStack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.s.Clear()
+}
+
+// All - returns iterator over snapshot of Stack elements in LIFO order,
+// where index 0 is the top element.
+// Stack can be modified during iteration.
+func (s *SyncStack[T]) All() iter.Seq2[int, T] {
+	s.mu.RLock()
+	snapshot := s.s.Clone()
+	s.mu.RUnlock()
+
+	return snapshot.All()
+}
@@ -0,0 +1,90 @@
+// Package avltree - ordered map, based on AVL tree.
+package avltree
+
+import (
+	"cmp"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/ordered"
+)
+
+var _ containers.Map[int, any] = (*Tree[int, any])(nil)
+
+// Tree - represents an AVL tree, that maps ordered keys to values.
+// Heights of children of every Node differ at most by one,
+// so operations take O(log(n)) time.
+// Queries are shared with other trees by ordered.Map.
+type Tree[K, V any] struct {
+	ordered.Map[K, V]
+}
+
+// avl - inserts and deletes entries, keeping Tree balanced.
+type avl[K, V any] struct{}
+
+// New - returns new empty Tree, ordered by natural order of keys.
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc - returns new empty Tree, ordered by compare.
+func NewFunc[K, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{ordered.New[K, V](compare, avl[K, V]{})}
+}
+
+// balanceFactor - returns difference of left and right subtrees heights.
+func balanceFactor[K, V any](n *ordered.Node[K, V]) int {
+	return ordered.Height(n.Left) - ordered.Height(n.Right)
+}
+
+// rotateLeft - lifts right child of n, returns it as new subtree root.
+func rotateLeft[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	x := n.Right
+	n.Right, x.Left = x.Left, n
+	n.Update()
+	x.Update()
+	return x
+}
+
+// rotateRight - lifts left child of n, returns it as new subtree root.
+func rotateRight[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	x := n.Left
+	n.Left, x.Right = x.Right, n
+	n.Update()
+	x.Update()
+	return x
+}
+
+// rebalance - restores balance of n, which children are balanced,
+// returns new subtree root.
+func rebalance[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	n.Update()
+
+	switch bf := balanceFactor(n); {
+	case bf > 1:
+		if balanceFactor(n.Left) < 0 {
+			n.Left = rotateLeft(n.Left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if balanceFactor(n.Right) > 0 {
+			n.Right = rotateRight(n.Right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+// Insert - inserts entry into n subtree, returns its balanced root.
+func (avl[K, V]) Insert(
+	n *ordered.Node[K, V], key K, value V, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	return ordered.Insert(n, key, value, compare, rebalance)
+}
+
+// Delete - deletes entry from n subtree, returns its balanced root.
+// Node with two children is replaced by its successor.
+func (avl[K, V]) Delete(
+	n *ordered.Node[K, V], key K, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	return ordered.Delete(n, key, compare, rebalance)
+}
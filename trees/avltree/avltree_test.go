@@ -0,0 +1,147 @@
+package avltree
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/seriozhakorneev/go-data-structures/internal/ordered"
+)
+
+// checkAVL - checks order of keys, heights, balance of Node's and Len.
+func checkAVL[K, V any](t *testing.T, tree *Tree[K, V]) {
+	t.Helper()
+
+	if err := ordered.Validate(&tree.Map); err != nil {
+		t.Fatal(err)
+	}
+
+	var rec func(n *ordered.Node[K, V])
+	rec = func(n *ordered.Node[K, V]) {
+		if n == nil {
+			return
+		}
+		if l, r := ordered.Height(n.Left), ordered.Height(n.Right); l-r > 1 || r-l > 1 {
+			t.Fatalf("Expected balanced Node %v\nGot heights: %d, %d", n.Key, l, r)
+		}
+		rec(n.Left)
+		rec(n.Right)
+	}
+	rec(ordered.Root(&tree.Map))
+}
+
+func TestSortedInsert(t *testing.T) {
+	t.Parallel()
+
+	const n = 1 << 12
+	tree := New[int, int]()
+	for k := range n {
+		tree.Insert(k, k)
+	}
+	checkAVL(t, tree)
+
+	// height of AVL tree is less than 1.44*log2(n+2)
+	if limit := int(1.44 * math.Log2(n+2)); tree.Depth() >= limit {
+		t.Fatalf("Expected depth less than: %d\nGot: %d", limit, tree.Depth())
+	}
+
+	for k := range n / 2 {
+		tree.Delete(k)
+	}
+	checkAVL(t, tree)
+	if k, _, _ := tree.Min(); k != n/2 {
+		t.Fatalf("Expected min: %d\nGot: %d", n/2, k)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	t.Parallel()
+
+	tree := New[int, string]()
+	if _, _, ok := tree.Max(); ok || tree.Depth() != -1 {
+		t.Fatalf("Expected empty Tree")
+	}
+	for _, k := range []int{10, 20, 30, 40} {
+		tree.Insert(k, "")
+	}
+
+	tests := []struct {
+		name string
+		find func(int) (int, string, bool)
+		key  int
+		exp  int
+		ok   bool
+	}{
+		{"floor", tree.Floor, 25, 20, true},
+		{"floor", tree.Floor, 5, 0, false},
+		{"ceiling", tree.Ceiling, 25, 30, true},
+		{"ceiling", tree.Ceiling, 45, 0, false},
+		{"predecessor", tree.Predecessor, 20, 10, true},
+		{"successor", tree.Successor, 20, 30, true},
+		{"successor", tree.Successor, 40, 0, false},
+	}
+	for _, tt := range tests {
+		if k, _, ok := tt.find(tt.key); k != tt.exp || ok != tt.ok {
+			t.Fatalf("Expected %s of %d: %d, %v\nGot: %d, %v", tt.name, tt.key, tt.exp, tt.ok, k, ok)
+		}
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	t.Parallel()
+
+	for seed := range uint64(10) {
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		tree := New[int, int]()
+		model := map[int]int{}
+
+		for op := range 2000 {
+			k := rnd.IntN(200)
+			switch rnd.IntN(4) {
+			case 0, 1:
+				tree.Insert(k, op)
+				model[k] = op
+			case 2:
+				_, exist := model[k]
+				if tree.Delete(k) != exist {
+					t.Fatalf("Expected delete of key %d: %v", k, exist)
+				}
+				delete(model, k)
+			case 3:
+				v, ok := tree.Search(k)
+				if exp, exist := model[k]; ok != exist || v != exp {
+					t.Fatalf("Expected search of key %d: %d, %v\nGot: %d, %v", k, exp, exist, v, ok)
+				}
+			}
+			if op%100 == 0 {
+				checkAVL(t, tree)
+			}
+		}
+		checkAVL(t, tree)
+
+		keys := make([]int, 0, len(model))
+		for k := range model {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		var got []int
+		for k := range tree.All() {
+			got = append(got, k)
+		}
+		if !reflect.DeepEqual(got, keys) {
+			t.Fatalf("Expected keys: %v\nGot: %v", keys, got)
+		}
+
+		var backward []int
+		for k := range tree.Backward() {
+			backward = append(backward, k)
+		}
+		slices.Reverse(backward)
+		if !reflect.DeepEqual(backward, keys) {
+			t.Fatalf("Expected backward keys: %v\nGot: %v", keys, backward)
+		}
+	}
+}
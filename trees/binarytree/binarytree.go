@@ -1,20 +1,43 @@
 package binarytree
 
+import (
+	"fmt"
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/queue"
+)
+
 // Tree represents a binary tree
 // that holds values of any type.
 type Tree[T any] struct {
-	Root       *Node[T]
+	Root *Node[T]
+	// Len, Depth - snapshot of Size and Height, taken by NewTree
+	// and CalcDepNLen only, they are not updated as Node's are added.
+	//
+	// Deprecated: use Size and Height, which are kept exact.
 	Len, Depth int
 }
 
 // Node represents a binary tree Node
 // that holds values of any type.
+// Left and Right changed by AddLeft, AddRight and Detach keep sizes
+// and heights of subtrees exact, after changing them by hand,
+// or building Node's as literals, call Tree.Recompute.
 type Node[T any] struct {
 	Data  T
 	Left  *Node[T]
 	Right *Node[T]
+
+	// parent - Node, which child n is, nil for root.
+	parent *Node[T]
+	// descendants - count of Node's in n subtree except n,
+	// edges - count of edges on the longest path from n down to leaf,
+	// zero values are exact for leaf.
+	descendants, edges int
 }
 
+// NewTree - returns Tree with single root Node,
+// Len and Depth are set to snapshot of Size and Height.
 func NewTree[T any](rootData T) Tree[T] {
 	return Tree[T]{
 		Root: &Node[T]{
@@ -25,38 +48,219 @@ func NewTree[T any](rootData T) Tree[T] {
 	}
 }
 
+// AddLeft - sets new Node with val as left child of n, returns it.
+// Previous left subtree is detached.
 func (n *Node[T]) AddLeft(val T) *Node[T] {
 	left := &Node[T]{Data: val}
-	n.Left = left
+	n.attach(&n.Left, left)
 	return left
 }
 
+// AddRight - sets new Node with val as right child of n, returns it.
+// Previous right subtree is detached.
 func (n *Node[T]) AddRight(val T) *Node[T] {
 	right := &Node[T]{Data: val}
-	n.Right = right
+	n.attach(&n.Right, right)
 	return right
 }
 
+// Detach - removes n subtree from its parent,
+// so n becomes root of a separate tree. Takes O(h) time.
+func (n *Node[T]) Detach() {
+	p := n.parent
+	if p == nil {
+		return
+	}
+
+	if p.Left == n {
+		p.Left = nil
+	} else {
+		p.Right = nil
+	}
+	n.parent = nil
+	p.fix()
+}
+
+// attach - replaces child of n at link with child,
+// updates sizes and heights up to root in O(h) time.
+func (n *Node[T]) attach(link **Node[T], child *Node[T]) {
+	if *link != nil {
+		(*link).parent = nil
+	}
+	*link, child.parent = child, n
+	n.fix()
+}
+
+// fix - recalculates sizes and heights of n and its ancestors
+// from their children.
+func (n *Node[T]) fix() {
+	for ; n != nil; n = n.parent {
+		n.descendants = n.Left.size() + n.Right.size()
+		n.edges = 1 + max(n.Left.height(), n.Right.height())
+	}
+}
+
+// Recompute - rebuilds parents, sizes and heights of all Node's
+// by single post-order walk in O(n) time.
+// Call it after Left or Right are changed by hand. Node's must form a tree.
+func (t *Tree[T]) Recompute() {
+	t.Root.recompute()
+}
+
+// recompute - rebuilds parents, sizes and heights of n subtree.
+func (n *Node[T]) recompute() {
+	if n == nil {
+		return
+	}
+
+	for _, child := range []*Node[T]{n.Left, n.Right} {
+		if child != nil {
+			child.recompute()
+			child.parent = n
+		}
+	}
+	n.descendants = n.Left.size() + n.Right.size()
+	n.edges = 1 + max(n.Left.height(), n.Right.height())
+}
+
+// CalcDepNLen - recomputes Tree, like Recompute does,
+// and sets Len and Depth to snapshot of exact Size and Height.
 func (t *Tree[T]) CalcDepNLen() {
-	var rec func(node *Node[T], depth int) int
+	t.Recompute()
+	t.Len, t.Depth = t.Size(), t.Height()
+}
+
+// Size - returns count of Tree Node's in O(1),
+// exact, unless links were changed by hand without Recompute.
+func (t *Tree[T]) Size() int {
+	return t.Root.size()
+}
+
+// Height - returns count of edges on the longest path from root to leaf
+// in O(1), -1 for Tree without root,
+// exact, unless links were changed by hand without Recompute.
+func (t *Tree[T]) Height() int {
+	return t.Root.height()
+}
+
+// size - returns count of Node's in n subtree.
+func (n *Node[T]) size() int {
+	if n == nil {
+		return 0
+	}
+	return 1 + n.descendants
+}
 
-	rec = func(node *Node[T], depth int) int {
-		if node == nil {
-			return depth
+// height - returns count of edges on the longest path
+// from n down to leaf, -1 for nil.
+func (n *Node[T]) height() int {
+	if n == nil {
+		return -1
+	}
+	return n.edges
+}
+
+// Validate - checks, that Node's form a tree, where every Node
+// is reachable from root only once, is linked to its parent,
+// and sizes and heights of subtrees are exact.
+// Returns error describing the first violation,
+// stale sizes and heights are fixed by Recompute.
+func (t *Tree[T]) Validate() error {
+	seen := map[*Node[T]]bool{}
+
+	stack := []*Node[T]{t.Root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n == nil {
+			continue
+		}
+
+		if seen[n] {
+			return fmt.Errorf("binarytree: node %v is reachable twice", n.Data)
+		}
+		seen[n] = true
+
+		for _, child := range []*Node[T]{n.Left, n.Right} {
+			if child != nil && child.parent != n {
+				return fmt.Errorf("binarytree: node %v is not linked to parent %v", child.Data, n.Data)
+			}
 		}
-		t.Len++
-		depth++
+		if size := n.Left.size() + n.Right.size(); n.descendants != size {
+			return fmt.Errorf("binarytree: node %v has %d descendants, expected %d", n.Data, n.descendants, size)
+		}
+		if edges := 1 + max(n.Left.height(), n.Right.height()); n.edges != edges {
+			return fmt.Errorf("binarytree: height of node %v is %d, expected %d", n.Data, n.edges, edges)
+		}
+		stack = append(stack, n.Left, n.Right)
+	}
+	return nil
+}
+
+// PreOrder - returns iterator over Node's data in pre-order: node, left, right.
+func (t *Tree[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Root.preOrder(yield)
+	}
+}
 
-		lDepth := rec(node.Left, depth)
-		rDepth := rec(node.Right, depth)
+// InOrder - returns iterator over Node's data in in-order: left, node, right.
+func (t *Tree[T]) InOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Root.inOrder(yield)
+	}
+}
+
+// PostOrder - returns iterator over Node's data in post-order: left, right, node.
+func (t *Tree[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Root.postOrder(yield)
+	}
+}
+
+// LevelOrder - returns iterator over Node's data level by level,
+// from left to right.
+func (t *Tree[T]) LevelOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		q := queue.New[*Node[T]](0)
+		if t.Root != nil {
+			q.Enqueue(t.Root)
+		}
 
-		if lDepth > rDepth {
-			return lDepth
+		for n, ok := q.Dequeue(); ok; n, ok = q.Dequeue() {
+			if !yield(n.Data) {
+				return
+			}
+			if n.Left != nil {
+				q.Enqueue(n.Left)
+			}
+			if n.Right != nil {
+				q.Enqueue(n.Right)
+			}
 		}
-		return rDepth
 	}
+}
 
-	t.Depth += rec(t.Root, t.Depth)
-	t.Depth--
-	t.Len--
+// preOrder - returns false, if yield stopped the traversal.
+func (n *Node[T]) preOrder(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return yield(n.Data) && n.Left.preOrder(yield) && n.Right.preOrder(yield)
+}
+
+// inOrder - returns false, if yield stopped the traversal.
+func (n *Node[T]) inOrder(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.Left.inOrder(yield) && yield(n.Data) && n.Right.inOrder(yield)
+}
+
+// postOrder - returns false, if yield stopped the traversal.
+func (n *Node[T]) postOrder(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.Left.postOrder(yield) && n.Right.postOrder(yield) && yield(n.Data)
 }
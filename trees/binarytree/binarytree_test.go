@@ -0,0 +1,238 @@
+package binarytree
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+// testTree - returns tree:
+//
+//	    1
+//	   / \
+//	  2   3
+//	 / \   \
+//	4   5   6
+func testTree() Tree[int] {
+	t := NewTree(1)
+	left := t.Root.AddLeft(2)
+	left.AddLeft(4)
+	left.AddRight(5)
+	t.Root.AddRight(3).AddRight(6)
+	return t
+}
+
+func TestTraversals(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	traversals := []struct {
+		name string
+		got  []int
+		exp  []int
+	}{
+		{"pre-order", slices.Collect(tree.PreOrder()), []int{1, 2, 4, 5, 3, 6}},
+		{"in-order", slices.Collect(tree.InOrder()), []int{4, 2, 5, 1, 3, 6}},
+		{"post-order", slices.Collect(tree.PostOrder()), []int{4, 5, 2, 6, 3, 1}},
+		{"level-order", slices.Collect(tree.LevelOrder()), []int{1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, tr := range traversals {
+		if !reflect.DeepEqual(tr.exp, tr.got) {
+			t.Fatalf("Expected %s: %v\nGot: %v", tr.name, tr.exp, tr.got)
+		}
+	}
+}
+
+func TestTraversalsEarlyExit(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	for _, seq := range []func(func(int) bool){
+		tree.PreOrder(), tree.InOrder(), tree.PostOrder(), tree.LevelOrder(),
+	} {
+		count := 0
+		for range seq {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+
+		if count != 3 {
+			t.Fatalf("Expected visited: 3\nGot: %d", count)
+		}
+	}
+}
+
+// randomTree - returns Tree of n Node's of random shape.
+func randomTree(rnd *rand.Rand, n int) Tree[int] {
+	t := NewTree(0)
+	nodes := []*Node[int]{t.Root}
+
+	for v := 1; v < n; v++ {
+		for {
+			parent := nodes[rnd.IntN(len(nodes))]
+			if parent.Left == nil && rnd.IntN(2) == 0 {
+				nodes = append(nodes, parent.AddLeft(v))
+				break
+			}
+			if parent.Right == nil {
+				nodes = append(nodes, parent.AddRight(v))
+				break
+			}
+		}
+	}
+	return t
+}
+
+// nodesOf - returns Node's of n subtree in pre-order.
+func nodesOf(n *Node[int]) []*Node[int] {
+	if n == nil {
+		return nil
+	}
+	return append(append([]*Node[int]{n}, nodesOf(n.Left)...), nodesOf(n.Right)...)
+}
+
+func TestSizeHeight(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	if tree.Size() != 6 || tree.Height() != 2 {
+		t.Fatalf("Expected size: 6, height: 2\nGot: %d, %d", tree.Size(), tree.Height())
+	}
+	if empty := (Tree[int]{}); empty.Size() != 0 || empty.Height() != -1 {
+		t.Fatalf("Expected size: 0, height: -1\nGot: %d, %d", empty.Size(), empty.Height())
+	}
+
+	// deprecated fields are snapshot only, left subtree of root is replaced
+	if tree.Len != 1 || tree.Depth != 0 {
+		t.Fatalf("Expected snapshot of NewTree: 1, 0\nGot: %d, %d", tree.Len, tree.Depth)
+	}
+	tree.Root.AddLeft(7)
+	tree.CalcDepNLen()
+	if tree.Len != 4 || tree.Depth != 2 {
+		t.Fatalf("Expected length: 4, depth: 2\nGot: %d, %d", tree.Len, tree.Depth)
+	}
+
+	property := func(seed uint64, size uint8) bool {
+		rnd := rand.New(rand.NewPCG(seed, 0))
+		n := int(size) + 1
+
+		tree := NewTree(0)
+		nodes := []*Node[int]{tree.Root}
+		for v := 1; v < n; v++ {
+			// replaces existing children too, detaching their subtrees
+			parent := nodes[rnd.IntN(len(nodes))]
+			if rnd.IntN(2) == 0 {
+				parent.AddLeft(v)
+			} else {
+				parent.AddRight(v)
+			}
+			nodes = nodesOf(tree.Root)
+
+			if tree.Validate() != nil || tree.Size() != len(nodes) ||
+				tree.Height() < bits.Len(uint(len(nodes)))-1 || tree.Height() >= len(nodes) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecompute(t *testing.T) {
+	t.Parallel()
+
+	//	    1
+	//	   / \
+	//	  2   3
+	//	 /
+	//	4
+	tree := Tree[int]{Root: &Node[int]{
+		Data:  1,
+		Left:  &Node[int]{Data: 2, Left: &Node[int]{Data: 4}},
+		Right: &Node[int]{Data: 3},
+	}}
+	if tree.Validate() == nil {
+		t.Fatalf("Expected stale sizes of literal Node's")
+	}
+
+	tree.Recompute()
+	if tree.Size() != 4 || tree.Height() != 2 {
+		t.Fatalf("Expected size: 4, height: 2\nGot: %d, %d", tree.Size(), tree.Height())
+	}
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// links changed by hand, then bookkeeping continues from recomputed values
+	tree.Root.Right.Right = &Node[int]{Data: 5}
+	tree.CalcDepNLen()
+	tree.Root.Right.Right.AddLeft(6)
+	if tree.Len != 5 || tree.Depth != 2 || tree.Size() != 6 || tree.Height() != 3 {
+		t.Fatalf("Expected length: 5, depth: 2, size: 6, height: 3\nGot: %d, %d, %d, %d",
+			tree.Len, tree.Depth, tree.Size(), tree.Height())
+	}
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDetach(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	left := tree.Root.Left
+	left.Detach()
+	if tree.Size() != 3 || tree.Height() != 2 || tree.Root.Left != nil {
+		t.Fatalf("Expected size: 3, height: 2\nGot: %d, %d", tree.Size(), tree.Height())
+	}
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	detached := Tree[int]{Root: left}
+	if detached.Size() != 3 || detached.Height() != 1 || detached.Validate() != nil {
+		t.Fatalf("Expected detached size: 3, height: 1\nGot: %d, %d", detached.Size(), detached.Height())
+	}
+	left.Detach()
+
+	tree.Root.Right.Right.Detach()
+	tree.Root.AddRight(7)
+	if tree.Size() != 2 || tree.Height() != 1 || tree.Validate() != nil {
+		t.Fatalf("Expected size: 2, height: 1\nGot: %d, %d", tree.Size(), tree.Height())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("Expected valid tree\nGot: %v", err)
+	}
+
+	// links changed bypassing AddLeft, AddRight and Detach
+	tree.Root.Left.Left = nil
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for stale size")
+	}
+
+	tree = testTree()
+
+	tree.Root.Right.Left = tree.Root.Left.Left
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for shared Node")
+	}
+
+	tree.Root.Right.Left = tree.Root
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for cycle")
+	}
+}
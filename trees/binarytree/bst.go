@@ -0,0 +1,67 @@
+package binarytree
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/ordered"
+)
+
+var _ containers.Map[int, any] = (*BST[int, any])(nil)
+
+// BST - represents a binary search tree, that maps ordered keys to values.
+// Operations take O(h) time, where h is height of tree,
+// tree is not balanced, so h is O(n) in the worst case.
+//
+// BST does not build on Node: Left and Right of Node are open for changes,
+// which could break the order of keys, and Node has no key or height.
+// Instead BST keeps entries in Node's of ordered.Map, sharing its queries
+// with avltree and rbtree, and implements only its own insert and delete.
+type BST[K, V any] struct {
+	ordered.Map[K, V]
+}
+
+// bst - inserts and deletes entries without balancing,
+// updating heights of Node's on the path.
+type bst[K, V any] struct{}
+
+// NewBST - returns new empty BST, ordered by natural order of keys.
+func NewBST[K cmp.Ordered, V any]() *BST[K, V] {
+	return NewBSTFunc[K, V](cmp.Compare[K])
+}
+
+// NewBSTFunc - returns new empty BST, ordered by compare.
+func NewBSTFunc[K, V any](compare func(a, b K) int) *BST[K, V] {
+	return &BST[K, V]{ordered.New[K, V](compare, bst[K, V]{})}
+}
+
+// update - recalculates height of n, returns n.
+func update[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	n.Update()
+	return n
+}
+
+// Insert - inserts entry into n subtree, returns its root.
+func (bst[K, V]) Insert(
+	n *ordered.Node[K, V], key K, value V, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	return ordered.Insert(n, key, value, compare, update)
+}
+
+// Delete - deletes entry from n subtree, returns its root.
+// Node with two children is replaced by its successor.
+func (bst[K, V]) Delete(
+	n *ordered.Node[K, V], key K, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	return ordered.Delete(n, key, compare, update)
+}
+
+// Validate - checks structural invariants of BST: order of keys,
+// heights of Node's and Len. Returns error describing the first violation.
+func (t *BST[K, V]) Validate() error {
+	if err := ordered.Validate(&t.Map); err != nil {
+		return fmt.Errorf("binarytree: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package binarytree
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/seriozhakorneev/go-data-structures/internal/ordered"
+)
+
+// checkBST - fails, if tree is not valid.
+func checkBST[K, V any](t *testing.T, tree *BST[K, V]) {
+	t.Helper()
+
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testBST - returns BST with keys:
+//
+//	     8
+//	   /   \
+//	  4     12
+//	 / \   /  \
+//	2   6 10   14
+func testBST() *BST[int, string] {
+	tree := NewBST[int, string]()
+	for _, k := range []int{8, 4, 12, 2, 6, 10, 14} {
+		tree.Insert(k, strings.Repeat("*", k))
+	}
+	return tree
+}
+
+func TestBSTInsertSearch(t *testing.T) {
+	t.Parallel()
+
+	tree := NewBST[int, string]()
+	if tree.Depth() != -1 {
+		t.Fatalf("Expected depth of empty BST: -1\nGot: %d", tree.Depth())
+	}
+	if _, _, ok := tree.Min(); ok {
+		t.Fatalf("Expected no Min of empty BST")
+	}
+
+	tree = testBST()
+	tree.Insert(6, "six")
+	checkBST(t, tree)
+
+	if tree.Len() != 7 || tree.Depth() != 2 {
+		t.Fatalf("Expected length: 7, depth: 2\nGot: %d, %d", tree.Len(), tree.Depth())
+	}
+	if v, ok := tree.Search(6); !ok || v != "six" {
+		t.Fatalf("Expected: six, true\nGot: %s, %v", v, ok)
+	}
+	if _, ok := tree.Get(7); ok {
+		t.Fatalf("Expected missing key: 7")
+	}
+	if tree.Update(7, "") || !tree.Update(2, "two") {
+		t.Fatalf("Expected update of existing key only")
+	}
+
+	if k, _, _ := tree.Min(); k != 2 {
+		t.Fatalf("Expected min: 2\nGot: %d", k)
+	}
+	if k, _, _ := tree.Max(); k != 14 {
+		t.Fatalf("Expected max: 14\nGot: %d", k)
+	}
+}
+
+func TestBSTNeighbours(t *testing.T) {
+	t.Parallel()
+
+	tree := testBST()
+	tests := []struct {
+		name string
+		find func(int) (int, string, bool)
+		key  int
+		exp  int
+		ok   bool
+	}{
+		{"floor", tree.Floor, 7, 6, true},
+		{"floor", tree.Floor, 8, 8, true},
+		{"floor", tree.Floor, 1, 0, false},
+		{"ceiling", tree.Ceiling, 7, 8, true},
+		{"ceiling", tree.Ceiling, 10, 10, true},
+		{"ceiling", tree.Ceiling, 15, 0, false},
+		{"predecessor", tree.Predecessor, 8, 6, true},
+		{"predecessor", tree.Predecessor, 2, 0, false},
+		{"successor", tree.Successor, 6, 8, true},
+		{"successor", tree.Successor, 14, 0, false},
+	}
+
+	for _, tt := range tests {
+		if k, _, ok := tt.find(tt.key); k != tt.exp || ok != tt.ok {
+			t.Fatalf("Expected %s of %d: %d, %v\nGot: %d, %v", tt.name, tt.key, tt.exp, tt.ok, k, ok)
+		}
+	}
+}
+
+func TestBSTDelete(t *testing.T) {
+	t.Parallel()
+
+	tree := testBST()
+
+	// root with two children is replaced by successor
+	if !tree.Delete(8) || ordered.Root(&tree.Map).Key != 10 {
+		t.Fatalf("Expected root to be replaced by successor: 10\nGot: %d", ordered.Root(&tree.Map).Key)
+	}
+	if tree.Delete(8) {
+		t.Fatalf("Expected no delete of missing key: 8")
+	}
+	tree.Delete(2)
+	tree.Delete(4)
+	checkBST(t, tree)
+
+	var keys []int
+	for k := range tree.All() {
+		keys = append(keys, k)
+	}
+	if exp := []int{6, 10, 12, 14}; !reflect.DeepEqual(keys, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, keys)
+	}
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.Depth() != -1 {
+		t.Fatalf("Expected empty BST after Clear")
+	}
+}
+
+func TestBSTModel(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 1))
+	tree := NewBSTFunc[int, int](func(a, b int) int { return b - a })
+	model := map[int]int{}
+
+	for range 5000 {
+		k := rnd.IntN(300)
+		if rnd.IntN(3) < 2 {
+			tree.Insert(k, -k)
+			model[k] = -k
+			continue
+		}
+
+		_, exist := model[k]
+		if tree.Delete(k) != exist {
+			t.Fatalf("Expected delete of key %d: %v", k, exist)
+		}
+		delete(model, k)
+	}
+	checkBST(t, tree)
+
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+
+	var got []int
+	for k, v := range tree.All() {
+		if v != model[k] {
+			t.Fatalf("Expected value by key %d: %d\nGot: %d", k, model[k], v)
+		}
+		got = append(got, k)
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Fatalf("Expected keys: %v\nGot: %v", keys, got)
+	}
+
+	var backward []int
+	for k := range tree.Backward() {
+		backward = append(backward, k)
+	}
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(backward, keys) {
+		t.Fatalf("Expected backward keys: %v\nGot: %v", keys, backward)
+	}
+}
+
+func TestBSTProperties(t *testing.T) {
+	t.Parallel()
+
+	property := func(keys []int16) bool {
+		tree := NewBST[int16, int]()
+		unique := map[int16]bool{}
+		for _, k := range keys {
+			tree.Insert(k, int(k))
+			unique[k] = true
+		}
+		if tree.Validate() != nil || tree.Len() != len(unique) || tree.Depth() >= len(unique) {
+			return false
+		}
+
+		for i, k := range keys {
+			if i%2 == 0 && tree.Delete(k) {
+				delete(unique, k)
+			}
+		}
+		if tree.Validate() != nil || tree.Len() != len(unique) {
+			return false
+		}
+
+		for k := range tree.All() {
+			if !unique[k] {
+				return false
+			}
+		}
+		return true
+	}
+
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBSTValidate(t *testing.T) {
+	t.Parallel()
+
+	tree := testBST()
+	ordered.Root(&tree.Map).Left.Key = 9
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for keys out of order")
+	}
+
+	tree = testBST()
+	ordered.Root(&tree.Map).Height = 5
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for wrong height")
+	}
+
+	tree = testBST()
+	ordered.Root(&tree.Map).Left.Left = nil
+	if err := tree.Validate(); err == nil {
+		t.Fatalf("Expected error for wrong length")
+	}
+}
@@ -0,0 +1,192 @@
+package binarytree
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/seriozhakorneev/go-data-structures/stack"
+)
+
+// Traversal - order of Tree traversal, used by Walk.
+type Traversal int
+
+const (
+	// PreOrderTraversal - node, left, right.
+	PreOrderTraversal Traversal = iota
+	// InOrderTraversal - left, node, right.
+	InOrderTraversal
+	// PostOrderTraversal - left, right, node.
+	PostOrderTraversal
+	// LevelOrderTraversal - level by level, from left to right.
+	LevelOrderTraversal
+	// ZigZagTraversal - level by level, alternating direction.
+	ZigZagTraversal
+)
+
+// Walk - calls visit for Node's data in provided order, until visit
+// returns false. Returns false, if traversal was stopped by visit.
+// Iterative traversals are used, so deep Tree can't overflow call stack.
+func (t *Tree[T]) Walk(order Traversal, visit func(T) bool) bool {
+	var seq iter.Seq[T]
+	switch order {
+	case PreOrderTraversal:
+		seq = t.PreOrderIterative()
+	case InOrderTraversal:
+		seq = t.InOrderIterative()
+	case PostOrderTraversal:
+		seq = t.PostOrderIterative()
+	case LevelOrderTraversal:
+		seq = t.LevelOrder()
+	case ZigZagTraversal:
+		seq = t.ZigZag()
+	default:
+		panic(fmt.Sprintf("binarytree: unknown traversal %d", order))
+	}
+
+	for v := range seq {
+		if !visit(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// PreOrderIterative - the same as PreOrder, but uses Stack instead of recursion.
+func (t *Tree[T]) PreOrderIterative() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		st := stack.New[*Node[T]]()
+		if t.Root != nil {
+			st.Push(t.Root)
+		}
+
+		for n, ok := st.Pop(); ok; n, ok = st.Pop() {
+			if !yield(n.Data) {
+				return
+			}
+			if n.Right != nil {
+				st.Push(n.Right)
+			}
+			if n.Left != nil {
+				st.Push(n.Left)
+			}
+		}
+	}
+}
+
+// InOrderIterative - the same as InOrder, but uses Stack instead of recursion.
+func (t *Tree[T]) InOrderIterative() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		st := stack.New[*Node[T]]()
+
+		for cur := t.Root; cur != nil || !st.IsEmpty(); {
+			if cur != nil {
+				st.Push(cur)
+				cur = cur.Left
+				continue
+			}
+
+			n, _ := st.Pop()
+			if !yield(n.Data) {
+				return
+			}
+			cur = n.Right
+		}
+	}
+}
+
+// PostOrderIterative - the same as PostOrder, but uses Stack instead of recursion.
+func (t *Tree[T]) PostOrderIterative() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		st := stack.New[*Node[T]]()
+		// last - the last visited Node, its parent is visited next,
+		// if it is the right child.
+		var last *Node[T]
+
+		for cur := t.Root; cur != nil || !st.IsEmpty(); {
+			if cur != nil {
+				st.Push(cur)
+				cur = cur.Left
+				continue
+			}
+
+			top, _ := st.Top()
+			if top.Right != nil && top.Right != last {
+				cur = top.Right
+				continue
+			}
+
+			st.Pop()
+			if !yield(top.Data) {
+				return
+			}
+			last = top
+		}
+	}
+}
+
+// MorrisInOrder - returns iterator over Node's data in in-order,
+// using O(1) memory. Right links of Node's are temporarily threaded
+// to their in-order successors, and restored before iterator returns,
+// even if iteration is stopped early. Tree must not be read or modified
+// during iteration.
+func (t *Tree[T]) MorrisInOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// stopped - iteration is stopped, traversal continues
+		// only to restore threaded links.
+		stopped := false
+
+		for cur := t.Root; cur != nil; {
+			if cur.Left == nil {
+				stopped = stopped || !yield(cur.Data)
+				cur = cur.Right
+				continue
+			}
+
+			pred := cur.Left
+			for pred.Right != nil && pred.Right != cur {
+				pred = pred.Right
+			}
+
+			if pred.Right == nil {
+				pred.Right = cur
+				cur = cur.Left
+				continue
+			}
+
+			pred.Right = nil
+			stopped = stopped || !yield(cur.Data)
+			cur = cur.Right
+		}
+	}
+}
+
+// ZigZag - returns iterator over Node's data level by level,
+// the first level from left to right, the next from right to left, and so on.
+func (t *Tree[T]) ZigZag() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		level, next := stack.New[*Node[T]](), stack.New[*Node[T]]()
+		if t.Root != nil {
+			level.Push(t.Root)
+		}
+
+		for leftToRight := true; !level.IsEmpty(); leftToRight = !leftToRight {
+			for n, ok := level.Pop(); ok; n, ok = level.Pop() {
+				if !yield(n.Data) {
+					return
+				}
+
+				first, second := n.Left, n.Right
+				if !leftToRight {
+					first, second = second, first
+				}
+				if first != nil {
+					next.Push(first)
+				}
+				if second != nil {
+					next.Push(second)
+				}
+			}
+			level, next = next, level
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package binarytree
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestIterativeTraversals(t *testing.T) {
+	t.Parallel()
+
+	for seed := range uint64(20) {
+		rnd := rand.New(rand.NewPCG(seed, 0))
+		tree := randomTree(rnd, 1+rnd.IntN(100))
+
+		traversals := []struct {
+			name           string
+			recursive, got []int
+		}{
+			{"pre-order", slices.Collect(tree.PreOrder()), slices.Collect(tree.PreOrderIterative())},
+			{"in-order", slices.Collect(tree.InOrder()), slices.Collect(tree.InOrderIterative())},
+			{"post-order", slices.Collect(tree.PostOrder()), slices.Collect(tree.PostOrderIterative())},
+			{"morris in-order", slices.Collect(tree.InOrder()), slices.Collect(tree.MorrisInOrder())},
+		}
+
+		for _, tr := range traversals {
+			if !reflect.DeepEqual(tr.recursive, tr.got) {
+				t.Fatalf("Expected %s: %v\nGot: %v", tr.name, tr.recursive, tr.got)
+			}
+		}
+	}
+
+	var empty Tree[int]
+	for _, seq := range []func(func(int) bool){
+		empty.PreOrderIterative(), empty.InOrderIterative(), empty.PostOrderIterative(),
+		empty.MorrisInOrder(), empty.LevelOrder(), empty.ZigZag(),
+	} {
+		for v := range seq {
+			t.Fatalf("Expected no values of empty tree\nGot: %d", v)
+		}
+	}
+}
+
+func TestZigZag(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	tree.Root.Left.Left.AddRight(7)
+	tree.Root.Right.Right.AddLeft(8)
+
+	exp := []int{1, 3, 2, 4, 5, 6, 8, 7}
+	if got := slices.Collect(tree.ZigZag()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected zigzag: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestMorrisEarlyExit(t *testing.T) {
+	t.Parallel()
+
+	tree := randomTree(rand.New(rand.NewPCG(1, 1)), 50)
+	exp := slices.Collect(tree.InOrder())
+
+	for stop := 1; stop <= len(exp); stop++ {
+		var got []int
+		for v := range tree.MorrisInOrder() {
+			got = append(got, v)
+			if len(got) == stop {
+				break
+			}
+		}
+
+		if !reflect.DeepEqual(exp[:stop], got) {
+			t.Fatalf("Expected: %v\nGot: %v", exp[:stop], got)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Expected restored tree after %d values\nGot: %v", stop, err)
+		}
+	}
+
+	if got := slices.Collect(tree.InOrder()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected unchanged tree: %v\nGot: %v", exp, got)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	tree := testTree()
+	tests := []struct {
+		order Traversal
+		exp   []int
+	}{
+		{PreOrderTraversal, []int{1, 2, 4, 5, 3, 6}},
+		{InOrderTraversal, []int{4, 2, 5, 1, 3, 6}},
+		{PostOrderTraversal, []int{4, 5, 2, 6, 3, 1}},
+		{LevelOrderTraversal, []int{1, 2, 3, 4, 5, 6}},
+		{ZigZagTraversal, []int{1, 3, 2, 4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		var got []int
+		if !tree.Walk(tt.order, func(v int) bool {
+			got = append(got, v)
+			return true
+		}) {
+			t.Fatalf("Expected complete traversal %d", tt.order)
+		}
+		if !reflect.DeepEqual(tt.exp, got) {
+			t.Fatalf("Expected traversal %d: %v\nGot: %v", tt.order, tt.exp, got)
+		}
+
+		visited := 0
+		if tree.Walk(tt.order, func(int) bool {
+			visited++
+			return visited < 3
+		}) || visited != 3 {
+			t.Fatalf("Expected traversal %d stopped after: 3\nGot: %d", tt.order, visited)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected panic for unknown traversal")
+		}
+	}()
+	tree.Walk(Traversal(-1), func(int) bool { return true })
+}
+
+func BenchmarkInOrder(b *testing.B) {
+	tree := randomTree(rand.New(rand.NewPCG(1, 1)), 10_000)
+
+	for name, seq := range map[string]func(func(int) bool){
+		"recursive": tree.InOrder(),
+		"iterative": tree.InOrderIterative(),
+		"morris":    tree.MorrisInOrder(),
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for range seq {
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,492 @@
+// Package bplustree - ordered map, based on B+ tree.
+package bplustree
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+)
+
+var _ containers.Map[int, any] = (*Tree[int, any])(nil)
+
+// Default configuration of Tree.
+const (
+	DefaultOrder = 32
+	MinOrder     = 3
+)
+
+// Tree - represents a B+ tree of provided order, that maps ordered keys
+// to values. Entries are stored in leaves only, which are linked
+// in both directions, inner Node's hold keys to route search.
+// Every Node has at most order children or entries,
+// and at least half of them, except root.
+type Tree[K, V any] struct {
+	root   *node[K, V]
+	length int
+	order  int
+
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b K) int
+}
+
+// node - Tree Node. Leaf holds sorted keys with their values,
+// and links to neighbour leaves. Inner Node holds one more children
+// than keys, keys of i child are in [keys[i-1], keys[i]).
+type node[K, V any] struct {
+	keys []K
+	// children - children of inner Node.
+	children []*node[K, V]
+	// values - values of leaf keys.
+	values []V
+	// prev, next - neighbour leaves.
+	prev, next *node[K, V]
+	leaf       bool
+}
+
+// Entry - key and value pair.
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// New - returns new empty Tree of provided order, ordered by natural
+// order of keys. If order is less than MinOrder, DefaultOrder is used.
+func New[K cmp.Ordered, V any](order int) *Tree[K, V] {
+	return NewFunc[K, V](order, cmp.Compare[K])
+}
+
+// NewFunc - returns new empty Tree of provided order, ordered by compare.
+// If order is less than MinOrder, DefaultOrder is used.
+func NewFunc[K, V any](order int, compare func(a, b K) int) *Tree[K, V] {
+	if order < MinOrder {
+		order = DefaultOrder
+	}
+	return &Tree[K, V]{order: order, compare: compare}
+}
+
+// maxKeys - returns max count of keys in Node.
+func (t *Tree[K, V]) maxKeys() int {
+	return t.order - 1
+}
+
+// minKeys - returns min count of keys in Node, except root.
+func (t *Tree[K, V]) minKeys() int {
+	return (t.order+1)/2 - 1
+}
+
+// child - returns index of n child, that may hold key.
+func (t *Tree[K, V]) child(n *node[K, V], key K) int {
+	i, found := slices.BinarySearchFunc(n.keys, key, t.compare)
+	if found {
+		i++
+	}
+	return i
+}
+
+// findLeaf - returns leaf, that may hold key, or nil if Tree is empty.
+func (t *Tree[K, V]) findLeaf(key K) *node[K, V] {
+	n := t.root
+	for n != nil && !n.leaf {
+		n = n.children[t.child(n, key)]
+	}
+	return n
+}
+
+// Len - returns count of entries.
+func (t *Tree[K, V]) Len() int {
+	return t.length
+}
+
+// Depth - returns count of edges from root to leaves, -1 for empty Tree.
+func (t *Tree[K, V]) Depth() int {
+	depth := -1
+	for n := t.root; n != nil; depth++ {
+		if n.leaf {
+			n = nil
+		} else {
+			n = n.children[0]
+		}
+	}
+	return depth
+}
+
+// IsEmpty - returns true, if there are no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.length == 0
+}
+
+// Clear - deletes all entries.
+func (t *Tree[K, V]) Clear() {
+	t.root, t.length = nil, 0
+}
+
+// Get - returns value lying at provided key and true,
+// if entry with key not exist, returns zero value and false.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if leaf := t.findLeaf(key); leaf != nil {
+		if i, found := slices.BinarySearchFunc(leaf.keys, key, t.compare); found {
+			return leaf.values[i], true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Update - returns true, if value for key are set, else returns false.
+func (t *Tree[K, V]) Update(key K, value V) bool {
+	if leaf := t.findLeaf(key); leaf != nil {
+		if i, found := slices.BinarySearchFunc(leaf.keys, key, t.compare); found {
+			leaf.values[i] = value
+			return true
+		}
+	}
+	return false
+}
+
+// Insert - creates new entry with provided key, value,
+// or replaces value of existing one.
+func (t *Tree[K, V]) Insert(key K, value V) {
+	if t.root == nil {
+		t.root = &node[K, V]{keys: []K{key}, values: []V{value}, leaf: true}
+		t.length++
+		return
+	}
+
+	if sep, right := t.insert(t.root, key, value); right != nil {
+		t.root = &node[K, V]{
+			keys:     []K{sep},
+			children: []*node[K, V]{t.root, right},
+		}
+	}
+}
+
+// insert - inserts entry into n subtree. If n overflows, it is split,
+// and the least key of new right Node with the Node are returned.
+func (t *Tree[K, V]) insert(n *node[K, V], key K, value V) (K, *node[K, V]) {
+	if n.leaf {
+		i, found := slices.BinarySearchFunc(n.keys, key, t.compare)
+		if found {
+			n.values[i] = value
+		} else {
+			n.keys = slices.Insert(n.keys, i, key)
+			n.values = slices.Insert(n.values, i, value)
+			t.length++
+		}
+	} else {
+		i := t.child(n, key)
+		if sep, right := t.insert(n.children[i], key, value); right != nil {
+			n.keys = slices.Insert(n.keys, i, sep)
+			n.children = slices.Insert(n.children, i+1, right)
+		}
+	}
+
+	if len(n.keys) > t.maxKeys() {
+		return n.split()
+	}
+
+	var zero K
+	return zero, nil
+}
+
+// split - moves upper half of n to new Node, returns separator key
+// and new Node. Split leaf keeps its separator, inner Node passes it up.
+func (n *node[K, V]) split() (K, *node[K, V]) {
+	mid := len(n.keys) / 2
+	right := &node[K, V]{leaf: n.leaf}
+
+	if n.leaf {
+		right.keys = slices.Clone(n.keys[mid:])
+		right.values = slices.Clone(n.values[mid:])
+		clear(n.values[mid:])
+		n.values = n.values[:mid]
+
+		right.prev, right.next = n, n.next
+		if n.next != nil {
+			n.next.prev = right
+		}
+		n.next = right
+	} else {
+		right.keys = slices.Clone(n.keys[mid+1:])
+		right.children = slices.Clone(n.children[mid+1:])
+		clear(n.children[mid+1:])
+		n.children = n.children[:mid+1]
+	}
+
+	sep := n.keys[mid]
+	clear(n.keys[mid:])
+	n.keys = n.keys[:mid]
+	return sep, right
+}
+
+// Delete - returns true, if entry by provided key are deleted,
+// else return false.
+func (t *Tree[K, V]) Delete(key K) bool {
+	if t.root == nil || !t.delete(t.root, key) {
+		return false
+	}
+
+	if len(t.root.keys) == 0 {
+		if t.root.leaf {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	return true
+}
+
+// delete - deletes entry from n subtree, returns false if it is not found.
+// Child, that underflows, is refilled from sibling or merged with it.
+func (t *Tree[K, V]) delete(n *node[K, V], key K) bool {
+	if n.leaf {
+		i, found := slices.BinarySearchFunc(n.keys, key, t.compare)
+		if !found {
+			return false
+		}
+
+		n.keys = slices.Delete(n.keys, i, i+1)
+		n.values = slices.Delete(n.values, i, i+1)
+		t.length--
+		return true
+	}
+
+	i := t.child(n, key)
+	if !t.delete(n.children[i], key) {
+		return false
+	}
+
+	if len(n.children[i].keys) < t.minKeys() {
+		t.refill(n, i)
+	}
+	return true
+}
+
+// refill - restores min count of keys in i child of n,
+// by rotation from sibling, or by merge with it.
+func (t *Tree[K, V]) refill(n *node[K, V], i int) {
+	child := n.children[i]
+
+	if i > 0 {
+		if left := n.children[i-1]; len(left.keys) > t.minKeys() {
+			last := len(left.keys) - 1
+			if child.leaf {
+				child.keys = slices.Insert(child.keys, 0, left.keys[last])
+				child.values = slices.Insert(child.values, 0, left.values[last])
+				left.values = slices.Delete(left.values, last, last+1)
+				n.keys[i-1] = child.keys[0]
+			} else {
+				child.keys = slices.Insert(child.keys, 0, n.keys[i-1])
+				child.children = slices.Insert(child.children, 0, left.children[last+1])
+				left.children = slices.Delete(left.children, last+1, last+2)
+				n.keys[i-1] = left.keys[last]
+			}
+			left.keys = slices.Delete(left.keys, last, last+1)
+			return
+		}
+	}
+
+	if i < len(n.keys) {
+		if right := n.children[i+1]; len(right.keys) > t.minKeys() {
+			if child.leaf {
+				child.keys = append(child.keys, right.keys[0])
+				child.values = append(child.values, right.values[0])
+				right.values = slices.Delete(right.values, 0, 1)
+				n.keys[i] = right.keys[1]
+			} else {
+				child.keys = append(child.keys, n.keys[i])
+				child.children = append(child.children, right.children[0])
+				right.children = slices.Delete(right.children, 0, 1)
+				n.keys[i] = right.keys[0]
+			}
+			right.keys = slices.Delete(right.keys, 0, 1)
+			return
+		}
+	}
+
+	if i == len(n.keys) {
+		i--
+	}
+	n.merge(i)
+}
+
+// merge - joins i and i+1 children of n, removing key i between them.
+func (n *node[K, V]) merge(i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	if left.leaf {
+		left.keys = append(left.keys, right.keys...)
+		left.values = append(left.values, right.values...)
+
+		left.next = right.next
+		if right.next != nil {
+			right.next.prev = left
+		}
+	} else {
+		left.keys = append(left.keys, n.keys[i])
+		left.keys = append(left.keys, right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+
+	n.keys = slices.Delete(n.keys, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// DeleteRange - deletes entries with keys in [from, to), returns their count.
+// Takes O(m*log(n)) time for m deleted entries,
+// or O(n) if Tree is rebuilt, when large part of it is deleted.
+func (t *Tree[K, V]) DeleteRange(from, to K) int {
+	var keys []K
+	for k := range t.Range(from, to) {
+		keys = append(keys, k)
+	}
+
+	if len(keys) < t.length/4 {
+		for _, k := range keys {
+			t.Delete(k)
+		}
+		return len(keys)
+	}
+
+	rest := make([]Entry[K, V], 0, t.length-len(keys))
+	for k, v := range t.All() {
+		if t.compare(k, from) < 0 || t.compare(k, to) >= 0 {
+			rest = append(rest, Entry[K, V]{k, v})
+		}
+	}
+	t.Load(rest)
+	return len(keys)
+}
+
+// Load - replaces entries of Tree with provided ones in O(n),
+// building Tree bottom up from full leaves. Entries must be sorted
+// by keys without duplicates, else they are inserted one by one.
+func (t *Tree[K, V]) Load(entries []Entry[K, V]) {
+	t.Clear()
+
+	sorted := slices.IsSortedFunc(entries, func(a, b Entry[K, V]) int {
+		if c := t.compare(a.Key, b.Key); c != 0 {
+			return c
+		}
+		return -1 // duplicates are not sorted
+	})
+	if !sorted {
+		for _, e := range entries {
+			t.Insert(e.Key, e.Value)
+		}
+		return
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	// level - Node's of current level with the least keys of their subtrees.
+	var level []*node[K, V]
+	var lows []K
+
+	var prev *node[K, V]
+	for _, chunk := range chunks(len(entries), t.maxKeys()) {
+		leaf := &node[K, V]{leaf: true, prev: prev}
+		for _, e := range entries[chunk[0]:chunk[1]] {
+			leaf.keys = append(leaf.keys, e.Key)
+			leaf.values = append(leaf.values, e.Value)
+		}
+		if prev != nil {
+			prev.next = leaf
+		}
+
+		level, lows = append(level, leaf), append(lows, leaf.keys[0])
+		prev = leaf
+	}
+
+	for len(level) > 1 {
+		var parents []*node[K, V]
+		var parentLows []K
+
+		for _, chunk := range chunks(len(level), t.order) {
+			parent := &node[K, V]{
+				keys:     slices.Clone(lows[chunk[0]+1 : chunk[1]]),
+				children: slices.Clone(level[chunk[0]:chunk[1]]),
+			}
+			parents, parentLows = append(parents, parent), append(parentLows, lows[chunk[0]])
+		}
+		level, lows = parents, parentLows
+	}
+
+	t.root, t.length = level[0], len(entries)
+}
+
+// chunks - splits n elements into the least count of nearly equal
+// chunks of at most size elements, returns their bounds.
+func chunks(n, size int) [][2]int {
+	count := (n + size - 1) / size
+	bounds := make([][2]int, count)
+
+	start := 0
+	for i := range bounds {
+		end := start + n/count
+		if i < n%count {
+			end++
+		}
+		bounds[i] = [2]int{start, end}
+		start = end
+	}
+	return bounds
+}
+
+// first - returns the leftmost leaf, or nil if Tree is empty.
+func (t *Tree[K, V]) first() *node[K, V] {
+	n := t.root
+	for n != nil && !n.leaf {
+		n = n.children[0]
+	}
+	return n
+}
+
+// last - returns the rightmost leaf, or nil if Tree is empty.
+func (t *Tree[K, V]) last() *node[K, V] {
+	n := t.root
+	for n != nil && !n.leaf {
+		n = n.children[len(n.children)-1]
+	}
+	return n
+}
+
+// All - returns iterator over entries in increasing order of keys.
+// Tree must not be modified during iteration.
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for c := t.First(); c.Valid(); c.Next() {
+			if !yield(c.Key(), c.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns iterator over entries in decreasing order of keys.
+// Tree must not be modified during iteration.
+func (t *Tree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for c := t.Last(); c.Valid(); c.Prev() {
+			if !yield(c.Key(), c.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Range - returns iterator over entries with keys in [from, to),
+// in increasing order. Tree must not be modified during iteration.
+func (t *Tree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for c := t.Seek(from); c.Valid() && t.compare(c.Key(), to) < 0; c.Next() {
+			if !yield(c.Key(), c.Value()) {
+				return
+			}
+		}
+	}
+}
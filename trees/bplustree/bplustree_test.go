@@ -0,0 +1,302 @@
+package bplustree
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// checkTree - checks fill and order of Node's, depth and links of leaves,
+// and Len.
+func checkTree(t *testing.T, tree *Tree[int, int]) {
+	t.Helper()
+
+	var leaves []*node[int, int]
+	leafDepth := -1
+
+	var rec func(n *node[int, int], depth int, low, high *int)
+	rec = func(n *node[int, int], depth int, low, high *int) {
+		if n != tree.root && (len(n.keys) < tree.minKeys() || len(n.keys) > tree.maxKeys()) {
+			t.Fatalf("Expected keys count in [%d, %d]\nGot: %d", tree.minKeys(), tree.maxKeys(), len(n.keys))
+		}
+		for i, k := range n.keys {
+			if i > 0 && n.keys[i-1] >= k || low != nil && k < *low || high != nil && k >= *high {
+				t.Fatalf("Expected key %d in order", k)
+			}
+		}
+
+		if n.leaf {
+			if len(n.values) != len(n.keys) {
+				t.Fatalf("Expected values count: %d\nGot: %d", len(n.keys), len(n.values))
+			}
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			if depth != leafDepth {
+				t.Fatalf("Expected leaf depth: %d\nGot: %d", leafDepth, depth)
+			}
+			leaves = append(leaves, n)
+			return
+		}
+
+		if len(n.children) != len(n.keys)+1 {
+			t.Fatalf("Expected children count: %d\nGot: %d", len(n.keys)+1, len(n.children))
+		}
+		for i, child := range n.children {
+			lo, hi := low, high
+			if i > 0 {
+				lo = &n.keys[i-1]
+			}
+			if i < len(n.keys) {
+				hi = &n.keys[i]
+			}
+			rec(child, depth+1, lo, hi)
+		}
+	}
+
+	if tree.root != nil {
+		rec(tree.root, 0, nil, nil)
+	}
+
+	count := 0
+	for i, leaf := range leaves {
+		var prev, next *node[int, int]
+		if i > 0 {
+			prev = leaves[i-1]
+		}
+		if i < len(leaves)-1 {
+			next = leaves[i+1]
+		}
+		if leaf.prev != prev || leaf.next != next {
+			t.Fatalf("Expected leaves linked in order")
+		}
+		count += len(leaf.keys)
+	}
+
+	if count != tree.Len() || leafDepth != tree.Depth() {
+		t.Fatalf("Expected length: %d, depth: %d\nGot: %d, %d", count, leafDepth, tree.Len(), tree.Depth())
+	}
+}
+
+// keys - returns keys of iterator.
+func keys(seq func(func(int, int) bool)) []int {
+	var result []int
+	for k := range seq {
+		result = append(result, k)
+	}
+	return result
+}
+
+func TestInsertGetDelete(t *testing.T) {
+	t.Parallel()
+
+	tree := New[int, int](3)
+	if tree.Depth() != -1 || tree.First().Valid() || tree.Last().Valid() {
+		t.Fatalf("Expected empty Tree")
+	}
+
+	for i := range 50 {
+		tree.Insert(i, i*10)
+		checkTree(t, tree)
+	}
+	tree.Insert(7, -7)
+
+	if v, ok := tree.Get(7); !ok || v != -7 {
+		t.Fatalf("Expected: -7, true\nGot: %d, %v", v, ok)
+	}
+	if _, ok := tree.Get(50); ok {
+		t.Fatalf("Expected missing key: 50")
+	}
+	if tree.Update(50, 0) || !tree.Update(8, -8) {
+		t.Fatalf("Expected update of existing key only")
+	}
+
+	for i := 0; i < 50; i += 2 {
+		if !tree.Delete(i) {
+			t.Fatalf("Expected delete of key: %d", i)
+		}
+		checkTree(t, tree)
+	}
+	if tree.Delete(0) {
+		t.Fatalf("Expected no delete of missing key: 0")
+	}
+
+	for i := range 50 {
+		tree.Delete(i)
+	}
+	checkTree(t, tree)
+	if !tree.IsEmpty() || tree.root != nil {
+		t.Fatalf("Expected empty Tree\nGot length: %d", tree.Len())
+	}
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	tree := New[int, int](4)
+	for i := 0; i < 100; i += 10 {
+		tree.Insert(i, i)
+	}
+
+	c := tree.Seek(35)
+	if !c.Valid() || c.Key() != 40 {
+		t.Fatalf("Expected Seek(35) at: 40")
+	}
+	c.Prev()
+	c.Prev()
+	if c.Key() != 20 || c.Value() != 20 {
+		t.Fatalf("Expected Cursor at: 20\nGot: %d", c.Key())
+	}
+	for c.Next() {
+	}
+	if c.Valid() || c.Next() || c.Prev() {
+		t.Fatalf("Expected invalid Cursor after the last entry")
+	}
+
+	if tree.Seek(91).Valid() {
+		t.Fatalf("Expected invalid Cursor of Seek after the last key")
+	}
+	if c := tree.Seek(-5); c.Key() != 0 {
+		t.Fatalf("Expected Seek(-5) at: 0\nGot: %d", c.Key())
+	}
+	if c := tree.Last(); c.Key() != 90 {
+		t.Fatalf("Expected last: 90\nGot: %d", c.Key())
+	}
+
+	exp := []int{30, 40, 50}
+	if got := keys(tree.Range(25, 60)); !reflect.DeepEqual(got, exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, got)
+	}
+
+	backward := keys(tree.Backward())
+	slices.Reverse(backward)
+	if forward := keys(tree.All()); !reflect.DeepEqual(backward, forward) {
+		t.Fatalf("Expected backward: %v\nGot: %v", forward, backward)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 2, 3, 7, 100, 1000} {
+		for _, order := range []int{3, 4, 5, DefaultOrder} {
+			entries := make([]Entry[int, int], n)
+			for i := range entries {
+				entries[i] = Entry[int, int]{i * 2, i}
+			}
+
+			tree := New[int, int](order)
+			tree.Insert(-1, -1)
+			tree.Load(entries)
+			checkTree(t, tree)
+
+			if tree.Len() != n {
+				t.Fatalf("Expected length: %d\nGot: %d", n, tree.Len())
+			}
+			for _, e := range entries {
+				if v, ok := tree.Get(e.Key); !ok || v != e.Value {
+					t.Fatalf("Expected value by key %d: %d\nGot: %d, %v", e.Key, e.Value, v, ok)
+				}
+			}
+		}
+	}
+
+	tree := New[int, int](3)
+	tree.Load([]Entry[int, int]{{3, 0}, {1, 0}, {2, 0}, {1, 1}})
+	checkTree(t, tree)
+	if exp := []int{1, 2, 3}; !reflect.DeepEqual(keys(tree.All()), exp) {
+		t.Fatalf("Expected unsorted entries to be inserted: %v\nGot: %v", exp, keys(tree.All()))
+	}
+	if v, _ := tree.Get(1); v != 1 {
+		t.Fatalf("Expected the last duplicate value: 1\nGot: %d", v)
+	}
+}
+
+func TestDeleteRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from, to, deleted int
+	}{
+		{10, 20, 10},  // deleted one by one
+		{0, 900, 900}, // rebuilt
+		{500, 400, 0},
+	}
+
+	for _, tt := range tests {
+		tree := New[int, int](5)
+		for i := range 1000 {
+			tree.Insert(i, i)
+		}
+
+		if got := tree.DeleteRange(tt.from, tt.to); got != tt.deleted {
+			t.Fatalf("Expected deleted: %d\nGot: %d", tt.deleted, got)
+		}
+		checkTree(t, tree)
+
+		if tree.Len() != 1000-tt.deleted {
+			t.Fatalf("Expected length: %d\nGot: %d", 1000-tt.deleted, tree.Len())
+		}
+		if got := keys(tree.Range(tt.from, tt.to)); len(got) != 0 {
+			t.Fatalf("Expected no keys in range\nGot: %v", got)
+		}
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	t.Parallel()
+
+	for _, order := range []int{3, 4, 5, 8, DefaultOrder} {
+		rnd := rand.New(rand.NewPCG(uint64(order), 1))
+		tree := New[int, int](order)
+		model := map[int]int{}
+
+		for op := range 3000 {
+			k := rnd.IntN(400)
+			switch rnd.IntN(5) {
+			case 0, 1, 2:
+				tree.Insert(k, op)
+				model[k] = op
+			case 3:
+				_, exist := model[k]
+				if tree.Delete(k) != exist {
+					t.Fatalf("Expected delete of key %d: %v", k, exist)
+				}
+				delete(model, k)
+			case 4:
+				to := k + rnd.IntN(10)
+				deleted := 0
+				for mk := range model {
+					if mk >= k && mk < to {
+						delete(model, mk)
+						deleted++
+					}
+				}
+				if got := tree.DeleteRange(k, to); got != deleted {
+					t.Fatalf("Expected deleted in [%d, %d): %d\nGot: %d", k, to, deleted, got)
+				}
+			}
+
+			if op%100 == 0 {
+				checkTree(t, tree)
+			}
+		}
+		checkTree(t, tree)
+
+		exp := make([]int, 0, len(model))
+		for k := range model {
+			exp = append(exp, k)
+		}
+		slices.Sort(exp)
+
+		if got := keys(tree.All()); !reflect.DeepEqual(got, exp) {
+			t.Fatalf("Expected keys of order %d tree: %v\nGot: %v", order, exp, got)
+		}
+		for k, v := range tree.All() {
+			if v != model[k] {
+				t.Fatalf("Expected value by key %d: %d\nGot: %d", k, model[k], v)
+			}
+		}
+	}
+}
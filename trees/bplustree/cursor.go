@@ -0,0 +1,91 @@
+package bplustree
+
+import "slices"
+
+// Cursor - points to Tree entry, moves over linked leaves
+// forward and backward in O(1) amortized time.
+// Cursor becomes invalid, when it moves past the ends of Tree,
+// and must not be used after Tree is modified.
+type Cursor[K, V any] struct {
+	leaf *node[K, V]
+	i    int
+}
+
+// First - returns Cursor at entry with the least key,
+// invalid if Tree is empty.
+func (t *Tree[K, V]) First() *Cursor[K, V] {
+	return &Cursor[K, V]{leaf: t.first()}
+}
+
+// Last - returns Cursor at entry with the greatest key,
+// invalid if Tree is empty.
+func (t *Tree[K, V]) Last() *Cursor[K, V] {
+	c := &Cursor[K, V]{leaf: t.last()}
+	if c.leaf != nil {
+		c.i = len(c.leaf.keys) - 1
+	}
+	return c
+}
+
+// Seek - returns Cursor at entry with the least key greater than
+// or equal to provided key, invalid if there is no such entry.
+func (t *Tree[K, V]) Seek(key K) *Cursor[K, V] {
+	c := &Cursor[K, V]{leaf: t.findLeaf(key)}
+	if c.leaf == nil {
+		return c
+	}
+
+	c.i, _ = slices.BinarySearchFunc(c.leaf.keys, key, t.compare)
+	if c.i == len(c.leaf.keys) {
+		c.leaf, c.i = c.leaf.next, 0
+	}
+	return c
+}
+
+// Valid - returns true, if Cursor points to entry.
+func (c *Cursor[K, V]) Valid() bool {
+	return c.leaf != nil
+}
+
+// Key - returns key of entry under valid Cursor.
+func (c *Cursor[K, V]) Key() K {
+	return c.leaf.keys[c.i]
+}
+
+// Value - returns value of entry under valid Cursor.
+func (c *Cursor[K, V]) Value() V {
+	return c.leaf.values[c.i]
+}
+
+// Next - moves Cursor to the next entry, returns false
+// if there is no such entry and Cursor becomes invalid.
+func (c *Cursor[K, V]) Next() bool {
+	if c.leaf == nil {
+		return false
+	}
+
+	c.i++
+	if c.i == len(c.leaf.keys) {
+		c.leaf, c.i = c.leaf.next, 0
+	}
+	return c.leaf != nil
+}
+
+// Prev - moves Cursor to the previous entry, returns false
+// if there is no such entry and Cursor becomes invalid.
+func (c *Cursor[K, V]) Prev() bool {
+	if c.leaf == nil {
+		return false
+	}
+
+	if c.i > 0 {
+		c.i--
+		return true
+	}
+
+	c.leaf = c.leaf.prev
+	if c.leaf != nil {
+		c.i = len(c.leaf.keys) - 1
+	}
+	return c.leaf != nil
+}
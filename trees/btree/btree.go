@@ -1,182 +1,509 @@
+// Package btree - ordered set of items, based on B-tree.
 package btree
 
 import (
-	"sort"
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
 )
 
-// Tree represents a b-tree
-// that holds values of any type.
-type Tree struct {
-	Root       *Node
-	Len, Depth int
+// Default configuration of Tree.
+const (
+	DefaultOrder = 32
+	MinOrder     = 3
+)
+
+// Tree - represents a B-tree of provided order, that holds unique items.
+// Every Node has at most order children, and at least half of them,
+// except root, all leaves are on the same depth,
+// so Insert, Delete and Get take O(log(n)) time.
+type Tree[T any] struct {
+	root   *node[T]
+	length int
+	// depth - count of edges from root to leaves, -1 for empty Tree.
+	depth int
+	order int
+
+	// compare - returns negative number if a < b, positive if a > b,
+	// and zero if they are equal.
+	compare func(a, b T) int
+}
+
+// node - Tree Node, that holds sorted items,
+// and one more children than items, if it is not a leaf.
+type node[T any] struct {
+	items    []T
+	children []*node[T]
 }
 
-// Node represents a b-tree Node
-// that holds values of any type.
-type Node struct {
-	Value int
-	Left  *Node
-	Right *Node
+// New - returns new empty Tree of provided order, ordered by natural
+// order of items. If order is less than MinOrder, DefaultOrder is used.
+func New[T cmp.Ordered](order int) *Tree[T] {
+	return NewFunc(order, cmp.Compare[T])
 }
 
-func NewTree(rootData int) Tree {
-	return Tree{
-		Root: &Node{
-			Value: rootData,
-		},
-		Len:   1,
-		Depth: 0,
+// NewFunc - returns new empty Tree of provided order, ordered by compare.
+// If order is less than MinOrder, DefaultOrder is used.
+func NewFunc[T any](order int, compare func(a, b T) int) *Tree[T] {
+	if order < MinOrder {
+		order = DefaultOrder
 	}
+	return &Tree[T]{depth: -1, order: order, compare: compare}
 }
 
-// AddNodes Add new Nodes with provided value/values
-// triggers tree balancing method
-func (t *Tree) AddNodes(values ...int) {
-	t.Balancing(values...)
+// FromSlice - returns Tree of DefaultOrder with items of a.
+func FromSlice[T cmp.Ordered](a []T) *Tree[T] {
+	return FromSliceFunc(a, cmp.Compare[T])
 }
 
-func GenFromRange(from, to int) Tree {
-	a := genSlice(from, to)
-	// get median index of array
-	// set it to tree Root
-	m := medianIndex(len(a))
-	t := NewTree(a[m])
-	f, s := a[:m], a[m+1:]
+// FromSliceFunc - returns Tree of DefaultOrder with items of a,
+// ordered by compare.
+func FromSliceFunc[T any](a []T, compare func(a, b T) int) *Tree[T] {
+	t := NewFunc(DefaultOrder, compare)
+	t.AddNodes(a...)
+	return t
+}
 
-	if len(f) > len(s) {
-		t.Depth = calcDepth(f)
+// GenFromRange - returns Tree of DefaultOrder with ints in [from, to].
+func GenFromRange(from, to int) *Tree[int] {
+	t := New[int](DefaultOrder)
+	for i := from; i <= to; i++ {
+		t.Insert(i)
 	}
-	t.Depth = calcDepth(s)
-
-	t.Root.Left = genNode(f, &t.Len)
-	t.Root.Right = genNode(s, &t.Len)
 	return t
 }
 
-func GenFromSlice(a []int) Tree {
-	if !sort.IntsAreSorted(a) {
-		sort.Ints(a)
-	}
+// GenFromSlice - returns Tree of DefaultOrder with ints of a.
+func GenFromSlice(a []int) *Tree[int] {
+	return FromSlice(a)
+}
+
+// maxItems - returns max count of items in Node.
+func (t *Tree[T]) maxItems() int {
+	return t.order - 1
+}
+
+// minItems - returns min count of items in Node, except root.
+func (t *Tree[T]) minItems() int {
+	return (t.order+1)/2 - 1
+}
 
-	// get median index of array
-	// set it to tree Root
-	m := medianIndex(len(a))
-	t := NewTree(a[m])
-	f, s := a[:m], a[m+1:]
+// leaf - returns true, if n has no children.
+func (n *node[T]) leaf() bool {
+	return len(n.children) == 0
+}
 
-	if len(f) > len(s) {
-		t.Depth = calcDepth(f)
+// find - returns index of the first item of n not less than provided,
+// and true if it is equal.
+func (t *Tree[T]) find(n *node[T], item T) (int, bool) {
+	return slices.BinarySearchFunc(n.items, item, t.compare)
+}
+
+// Len - returns count of items.
+func (t *Tree[T]) Len() int {
+	return t.length
+}
+
+// Depth - returns count of edges from root to leaves, -1 for empty Tree.
+func (t *Tree[T]) Depth() int {
+	return t.depth
+}
+
+// Order - returns max count of children of Tree Node's.
+func (t *Tree[T]) Order() int {
+	return t.order
+}
+
+// IsEmpty - returns true, if there are no items.
+func (t *Tree[T]) IsEmpty() bool {
+	return t.length == 0
+}
+
+// Clear - deletes all items.
+func (t *Tree[T]) Clear() {
+	t.root, t.length, t.depth = nil, 0, -1
+}
+
+// Get - returns item equal to provided and true,
+// if there is no such item, returns zero value and false.
+func (t *Tree[T]) Get(item T) (T, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.find(n, item)
+		if found {
+			return n.items[i], true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
 	}
-	t.Depth = calcDepth(s)
 
-	t.Root.Left = genNode(f, &t.Len)
-	t.Root.Right = genNode(s, &t.Len)
-	return t
+	var zero T
+	return zero, false
 }
 
-func genNode(a []int, length *int) *Node {
-	m := medianIndex(len(a))
-	if m == -1 {
-		return nil
+// Has - returns true, if Tree has item equal to provided.
+func (t *Tree[T]) Has(item T) bool {
+	_, ok := t.Get(item)
+	return ok
+}
+
+// Insert - adds item to Tree, or replaces equal one.
+func (t *Tree[T]) Insert(item T) {
+	if t.root == nil {
+		t.root = &node[T]{items: []T{item}}
+		t.length, t.depth = 1, 0
+		return
 	}
-	*length++
-	return &Node{
-		Value: a[m],
-		Left:  genNode(a[:m], length),
-		Right: genNode(a[m+1:], length),
+
+	if median, right := t.insert(t.root, item); right != nil {
+		t.root = &node[T]{
+			items:    []T{median},
+			children: []*node[T]{t.root, right},
+		}
+		t.depth++
 	}
 }
 
-func medianIndex(length int) int {
-	return (length+1)/2 - 1
+// AddNodes - inserts provided items.
+func (t *Tree[T]) AddNodes(items ...T) {
+	for _, item := range items {
+		t.Insert(item)
+	}
 }
 
-func genSlice(from, to int) []int {
-	var a []int
-	for i := from; i <= to; i++ {
-		a = append(a, i)
+// insert - adds item into n subtree. If n overflows, it is split,
+// and its median item with new right Node are returned.
+func (t *Tree[T]) insert(n *node[T], item T) (T, *node[T]) {
+	i, found := t.find(n, item)
+	switch {
+	case found:
+		n.items[i] = item
+	case n.leaf():
+		n.items = slices.Insert(n.items, i, item)
+		t.length++
+	default:
+		if median, right := t.insert(n.children[i], item); right != nil {
+			n.items = slices.Insert(n.items, i, median)
+			n.children = slices.Insert(n.children, i+1, right)
+		}
 	}
-	return a
+
+	if len(n.items) > t.maxItems() {
+		return n.split()
+	}
+
+	var zero T
+	return zero, nil
 }
 
-func calcDepth(a []int) int {
-	if len(a) > 2 {
-		depth := float64(len(a)) / float64(2)
-		if depth/10 != 0 {
-			depth++
-		}
-		return int(depth)
+// split - moves items and children after median to new Node,
+// returns median item and new Node.
+func (n *node[T]) split() (T, *node[T]) {
+	mid := len(n.items) / 2
+	median := n.items[mid]
+
+	right := &node[T]{items: slices.Clone(n.items[mid+1:])}
+	clear(n.items[mid:])
+	n.items = n.items[:mid]
+
+	if !n.leaf() {
+		right.children = slices.Clone(n.children[mid+1:])
+		clear(n.children[mid+1:])
+		n.children = n.children[:mid+1]
 	}
-	return len(a)
+
+	return median, right
 }
 
-// Balancing makes binary tree balanced b-tree
-// values can be added to slice on which origin
-// we will make new tree
-func (t *Tree) Balancing(values ...int) {
-	s := values
+// Delete - returns true, if item equal to provided is deleted,
+// else returns false.
+func (t *Tree[T]) Delete(item T) bool {
+	if t.root == nil || !t.delete(t.root, item) {
+		return false
+	}
 
-	s = travWithSort(t.Root, s...)
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+		t.depth--
+	}
+	return true
+}
 
-	m := medianIndex(len(s))
-	newT := NewTree(s[m])
-	f, s := s[:m], s[m+1:]
+// delete - deletes item from n subtree, returns false if it is not found.
+// Item of inner Node is replaced by its predecessor.
+// Child, that underflows, is refilled from sibling or merged with it.
+func (t *Tree[T]) delete(n *node[T], item T) bool {
+	i, found := t.find(n, item)
 
-	if len(f) > len(s) {
-		newT.Depth = calcDepth(f)
+	if n.leaf() {
+		if !found {
+			return false
+		}
+		n.items = slices.Delete(n.items, i, i+1)
+		t.length--
+		return true
 	}
-	newT.Depth = calcDepth(s)
 
-	newT.Root.Left = genNode(f, &newT.Len)
-	newT.Root.Right = genNode(s, &newT.Len)
+	if found {
+		predecessor := n.children[i].max()
+		n.items[i] = predecessor
+		t.delete(n.children[i], predecessor)
+	} else if !t.delete(n.children[i], item) {
+		return false
+	}
 
-	*t = newT
+	if len(n.children[i].items) < t.minItems() {
+		t.refill(n, i)
+	}
+	return true
 }
 
-// travWithSort InOrder traversal with easy sorting
-func travWithSort(n *Node, values ...int) (a []int) {
-	a = values
+// refill - restores min count of items in i child of n,
+// by rotation from sibling, or by merge with it.
+func (t *Tree[T]) refill(n *node[T], i int) {
+	child := n.children[i]
+
+	if i > 0 {
+		if left := n.children[i-1]; len(left.items) > t.minItems() {
+			last := len(left.items) - 1
+			child.items = slices.Insert(child.items, 0, n.items[i-1])
+			n.items[i-1] = left.items[last]
+			left.items = slices.Delete(left.items, last, last+1)
 
-	var inOrder func(node *Node)
-	inOrder = func(node *Node) {
-		if node == nil {
+			if !left.leaf() {
+				last = len(left.children) - 1
+				child.children = slices.Insert(child.children, 0, left.children[last])
+				left.children = slices.Delete(left.children, last, last+1)
+			}
 			return
 		}
+	}
+
+	if i < len(n.items) {
+		if right := n.children[i+1]; len(right.items) > t.minItems() {
+			child.items = append(child.items, n.items[i])
+			n.items[i] = right.items[0]
+			right.items = slices.Delete(right.items, 0, 1)
 
-		inOrder(node.Left)
-		a = append(a, node.Value)
-		sortLast(a)
-		inOrder(node.Right)
+			if !right.leaf() {
+				child.children = append(child.children, right.children[0])
+				right.children = slices.Delete(right.children, 0, 1)
+			}
+			return
+		}
 	}
 
-	inOrder(n)
-	return a
+	if i == len(n.items) {
+		i--
+	}
+	n.merge(i)
 }
 
-// GetSlice by returns slice a,
-// collected by inOrder traversal
-func (t *Tree) GetSlice() (a []int) {
-	var inOrder func(node *Node)
-	inOrder = func(node *Node) {
-		if node == nil {
-			return
+// merge - joins i and i+1 children of n with item i between them.
+func (n *node[T]) merge(i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// min - returns the least item of n subtree.
+func (n *node[T]) min() T {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.items[0]
+}
+
+// max - returns the greatest item of n subtree.
+func (n *node[T]) max() T {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.items[len(n.items)-1]
+}
+
+// Min - returns the least item,
+// if Tree is empty, returns zero value and false.
+func (t *Tree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	return t.root.min(), true
+}
+
+// Max - returns the greatest item,
+// if Tree is empty, returns zero value and false.
+func (t *Tree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	return t.root.max(), true
+}
+
+// ToSlice - returns all items in increasing order.
+func (t *Tree[T]) ToSlice() []T {
+	return slices.AppendSeq(make([]T, 0, t.length), t.All())
+}
+
+// GetSlice - returns all items in increasing order.
+//
+// Deprecated: use ToSlice.
+func (t *Tree[T]) GetSlice() []T {
+	return t.ToSlice()
+}
+
+// All - returns iterator over items in increasing order.
+// Tree must not be modified during iteration.
+func (t *Tree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// InOrder - the same as All.
+func (t *Tree[T]) InOrder() iter.Seq[T] {
+	return t.All()
+}
+
+// Backward - returns iterator over items in decreasing order.
+// Tree must not be modified during iteration.
+func (t *Tree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.descend(t.root, yield)
+	}
+}
+
+// Range - returns iterator over items in [from, to), in increasing order.
+// Tree must not be modified during iteration.
+func (t *Tree[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.ascend(t.root, &from, &to, yield)
+	}
+}
+
+// ascend - yields items of n subtree, not less than from and less than to,
+// if bounds are set. Returns false, if traversal is stopped.
+func (t *Tree[T]) ascend(n *node[T], from, to *T, yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	i := 0
+	if from != nil {
+		i, _ = t.find(n, *from)
+	}
+
+	for ; i < len(n.items); i++ {
+		if !n.leaf() && !t.ascend(n.children[i], from, to, yield) {
+			return false
 		}
+		if to != nil && t.compare(n.items[i], *to) >= 0 {
+			return false
+		}
+		if !yield(n.items[i]) {
+			return false
+		}
+	}
 
-		inOrder(node.Left)
-		a = append(a, node.Value)
-		inOrder(node.Right)
+	if !n.leaf() {
+		return t.ascend(n.children[i], from, to, yield)
 	}
+	return true
+}
 
-	inOrder(t.Root)
-	return a
+// descend - yields items of n subtree in decreasing order.
+// Returns false, if traversal is stopped.
+func (t *Tree[T]) descend(n *node[T], yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for i := len(n.items) - 1; i >= 0; i-- {
+		if !n.leaf() && !t.descend(n.children[i+1], yield) {
+			return false
+		}
+		if !yield(n.items[i]) {
+			return false
+		}
+	}
+
+	if !n.leaf() {
+		return t.descend(n.children[0], yield)
+	}
+	return true
 }
 
-// sortLast sorting all elements from last to first
-// sort s in increasing order
-func sortLast(s []int) {
-	for i := len(s) - 1; i > 0 && s[i-1] > s[i]; i-- {
-		tmp := s[i]
-		s[i] = s[i-1]
-		s[i-1] = tmp
+// Validate - checks structural invariants of Tree: order of items,
+// counts of items and children in Node's, equal depth of leaves,
+// Len and Depth. Returns error describing the first violation.
+func (t *Tree[T]) Validate() error {
+	count, depth := 0, -1
+
+	var rec func(n *node[T], level int, low, high *T) error
+	rec = func(n *node[T], level int, low, high *T) error {
+		if n != t.root && len(n.items) < t.minItems() || len(n.items) > t.maxItems() || len(n.items) == 0 {
+			return fmt.Errorf("btree: node with %d items, expected [%d, %d]", len(n.items), t.minItems(), t.maxItems())
+		}
+		for i, item := range n.items {
+			if i > 0 && t.compare(n.items[i-1], item) >= 0 ||
+				low != nil && t.compare(item, *low) <= 0 || high != nil && t.compare(item, *high) >= 0 {
+				return fmt.Errorf("btree: item %v out of order", item)
+			}
+		}
+		count += len(n.items)
+
+		if n.leaf() {
+			if depth == -1 {
+				depth = level
+			}
+			if level != depth {
+				return fmt.Errorf("btree: leaf on depth %d, expected %d", level, depth)
+			}
+			return nil
+		}
+
+		if len(n.children) != len(n.items)+1 {
+			return fmt.Errorf("btree: node with %d items has %d children", len(n.items), len(n.children))
+		}
+		for i, child := range n.children {
+			lo, hi := low, high
+			if i > 0 {
+				lo = &n.items[i-1]
+			}
+			if i < len(n.items) {
+				hi = &n.items[i]
+			}
+			if err := rec(child, level+1, lo, hi); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if t.root != nil {
+		if err := rec(t.root, 0, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	if count != t.length {
+		return fmt.Errorf("btree: length %d, expected %d", t.length, count)
+	}
+	if depth != t.depth {
+		return fmt.Errorf("btree: depth %d, expected %d", t.depth, depth)
 	}
+	return nil
 }
@@ -0,0 +1,266 @@
+package btree
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// checkTree - fails, if tree is not valid.
+func checkTree[T any](t *testing.T, tree *Tree[T]) {
+	t.Helper()
+
+	if err := tree.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGen(t *testing.T) {
+	t.Parallel()
+
+	tree := GenFromRange(1, 7)
+	exp := []int{1, 2, 3, 4, 5, 6, 7}
+	if got := slices.Collect(tree.InOrder()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected in-order: %v\nGot: %v", exp, got)
+	}
+
+	tree = GenFromSlice([]int{5, 3, 9, 3, 1})
+	exp = []int{1, 3, 5, 9}
+	if got := tree.ToSlice(); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, got)
+	}
+	checkTree(t, tree)
+}
+
+func TestKeyTypes(t *testing.T) {
+	t.Parallel()
+
+	words := FromSlice([]string{"pear", "apple", "fig"})
+	if exp := []string{"apple", "fig", "pear"}; !reflect.DeepEqual(words.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, words.ToSlice())
+	}
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := NewFunc(4, time.Time.Compare)
+	for i := range 10 {
+		times.Insert(day.Add(time.Duration(9-i) * time.Hour))
+	}
+	checkTree(t, times)
+	if first, _ := times.Min(); !first.Equal(day) {
+		t.Fatalf("Expected min: %v\nGot: %v", day, first)
+	}
+	if got := slices.Collect(times.Range(day.Add(time.Hour), day.Add(3*time.Hour))); len(got) != 2 {
+		t.Fatalf("Expected times in range: 2\nGot: %v", got)
+	}
+
+	// composite key, ordered by name then by id
+	type user struct {
+		name string
+		id   int
+	}
+	users := FromSliceFunc([]user{{"bob", 2}, {"alice", 7}, {"bob", 1}}, func(a, b user) int {
+		return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.id, b.id))
+	})
+	checkTree(t, users)
+
+	exp := []user{{"alice", 7}, {"bob", 1}, {"bob", 2}}
+	if !reflect.DeepEqual(users.ToSlice(), exp) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, users.ToSlice())
+	}
+	if u, ok := users.Get(user{"bob", 1}); !ok || u.id != 1 {
+		t.Fatalf("Expected user: bob 1\nGot: %v, %v", u, ok)
+	}
+}
+
+func TestInsertDelete(t *testing.T) {
+	t.Parallel()
+
+	tree := New[int](3)
+	if tree.Depth() != -1 || tree.Order() != 3 {
+		t.Fatalf("Expected empty Tree of order 3\nGot depth: %d, order: %d", tree.Depth(), tree.Order())
+	}
+	if _, ok := tree.Min(); ok {
+		t.Fatalf("Expected no Min of empty Tree")
+	}
+
+	for i := range 100 {
+		tree.Insert(i)
+		checkTree(t, tree)
+	}
+	if tree.Depth() > 6 {
+		t.Fatalf("Expected depth at most: 6\nGot: %d", tree.Depth())
+	}
+	if v, ok := tree.Get(42); !ok || v != 42 || tree.Has(100) {
+		t.Fatalf("Expected Get(42): 42, true\nGot: %d, %v", v, ok)
+	}
+
+	for i := 0; i < 100; i += 3 {
+		if !tree.Delete(i) {
+			t.Fatalf("Expected delete of item: %d", i)
+		}
+		checkTree(t, tree)
+	}
+	if tree.Delete(0) {
+		t.Fatalf("Expected no delete of missing item: 0")
+	}
+
+	if v, _ := tree.Min(); v != 1 {
+		t.Fatalf("Expected min: 1\nGot: %d", v)
+	}
+	if v, _ := tree.Max(); v != 98 {
+		t.Fatalf("Expected max: 98\nGot: %d", v)
+	}
+
+	for i := range 100 {
+		tree.Delete(i)
+	}
+	checkTree(t, tree)
+	if !tree.IsEmpty() || tree.root != nil {
+		t.Fatalf("Expected empty Tree\nGot length: %d", tree.Len())
+	}
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	tree := New[int](4)
+	for i := 0; i < 200; i += 2 {
+		tree.Insert(i)
+	}
+
+	exp := []int{10, 12, 14, 16, 18}
+	if got := slices.Collect(tree.Range(9, 20)); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected: %v\nGot: %v", exp, got)
+	}
+	if got := slices.Collect(tree.Range(20, 9)); len(got) != 0 {
+		t.Fatalf("Expected empty range\nGot: %v", got)
+	}
+
+	backward := slices.Collect(tree.Backward())
+	slices.Reverse(backward)
+	if !reflect.DeepEqual(backward, tree.ToSlice()) {
+		t.Fatalf("Expected backward: %v\nGot: %v", tree.ToSlice(), backward)
+	}
+
+	for _, seq := range []func(func(int) bool){tree.All(), tree.Backward(), tree.Range(0, 100)} {
+		var got []int
+		for v := range seq {
+			got = append(got, v)
+			if len(got) == 2 {
+				break
+			}
+		}
+
+		if len(got) != 2 {
+			t.Fatalf("Expected visited: 2\nGot: %v", got)
+		}
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	t.Parallel()
+
+	for _, order := range []int{3, 4, 5, 8, DefaultOrder} {
+		rnd := rand.New(rand.NewPCG(uint64(order), 0))
+		tree := New[int](order)
+		model := map[int]bool{}
+
+		for op := range 3000 {
+			item := rnd.IntN(500)
+			if rnd.IntN(3) < 2 {
+				tree.Insert(item)
+				model[item] = true
+			} else {
+				if tree.Delete(item) != model[item] {
+					t.Fatalf("Expected delete of item %d: %v", item, model[item])
+				}
+				delete(model, item)
+			}
+
+			if op%100 == 0 {
+				checkTree(t, tree)
+			}
+		}
+		checkTree(t, tree)
+
+		exp := make([]int, 0, len(model))
+		for item := range model {
+			exp = append(exp, item)
+		}
+		slices.Sort(exp)
+
+		if got := tree.ToSlice(); !reflect.DeepEqual(exp, got) {
+			t.Fatalf("Expected items of order %d tree: %v\nGot: %v", order, exp, got)
+		}
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		GenFromRange(1, 10_000)
+	}
+}
+
+func TestProperties(t *testing.T) {
+	t.Parallel()
+
+	property := func(items []int16, order uint8) bool {
+		tree := New[int16](MinOrder + int(order%16))
+		unique := map[int16]bool{}
+		for _, item := range items {
+			tree.Insert(item)
+			unique[item] = true
+		}
+		if tree.Validate() != nil || tree.Len() != len(unique) {
+			return false
+		}
+
+		for i, item := range items {
+			if i%2 == 0 && tree.Delete(item) {
+				delete(unique, item)
+			}
+		}
+		if tree.Validate() != nil || tree.Len() != len(unique) {
+			return false
+		}
+
+		return slices.IsSorted(tree.ToSlice()) && len(tree.ToSlice()) == len(unique)
+	}
+
+	if err := quick.Check(property, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	corruptions := map[string]func(tree *Tree[int]){
+		"order":     func(tree *Tree[int]) { tree.root.items[0], tree.root.items[1] = tree.root.items[1], tree.root.items[0] },
+		"length":    func(tree *Tree[int]) { tree.length-- },
+		"depth":     func(tree *Tree[int]) { tree.depth++ },
+		"underflow": func(tree *Tree[int]) { tree.root.children[0].items = tree.root.children[0].items[:0] },
+		"leaf depth": func(tree *Tree[int]) {
+			tree.root.children[0].children = nil
+		},
+	}
+
+	for name, corrupt := range corruptions {
+		tree := New[int](3)
+		for i := range 30 {
+			tree.Insert(i)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Expected valid tree\nGot: %v", err)
+		}
+
+		corrupt(tree)
+		if err := tree.Validate(); err == nil {
+			t.Fatalf("Expected error for corrupted %s", name)
+		}
+	}
+}
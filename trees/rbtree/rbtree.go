@@ -0,0 +1,201 @@
+// Package rbtree - ordered map, based on left-leaning red-black tree.
+package rbtree
+
+import (
+	"cmp"
+
+	"github.com/seriozhakorneev/go-data-structures/containers"
+	"github.com/seriozhakorneev/go-data-structures/internal/ordered"
+)
+
+var _ containers.Map[int, any] = (*Tree[int, any])(nil)
+
+// Tree - represents a left-leaning red-black tree, that maps ordered keys
+// to values. Every path from root to leaf has the same count of black Node's,
+// red Node's have black children and are only left ones,
+// so operations take O(log(n)) time.
+// Queries are shared with other trees by ordered.Map.
+type Tree[K, V any] struct {
+	ordered.Map[K, V]
+}
+
+// llrb - inserts and deletes entries, keeping left-leaning
+// red-black invariants. Red of Node is color of link from its parent.
+type llrb[K, V any] struct{}
+
+// New - returns new empty Tree, ordered by natural order of keys.
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewFunc[K, V](cmp.Compare[K])
+}
+
+// NewFunc - returns new empty Tree, ordered by compare.
+func NewFunc[K, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{ordered.New[K, V](compare, llrb[K, V]{})}
+}
+
+// isRed - returns true, if n is red, nil Node's are black.
+func isRed[K, V any](n *ordered.Node[K, V]) bool {
+	return n != nil && n.Red
+}
+
+// rotateLeft - lifts right child of n, returns it as new subtree root.
+func rotateLeft[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	x := n.Right
+	n.Right, x.Left = x.Left, n
+	x.Red, n.Red = n.Red, true
+	n.Update()
+	x.Update()
+	return x
+}
+
+// rotateRight - lifts left child of n, returns it as new subtree root.
+func rotateRight[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	x := n.Left
+	n.Left, x.Right = x.Right, n
+	x.Red, n.Red = n.Red, true
+	n.Update()
+	x.Update()
+	return x
+}
+
+// flipColors - inverts colors of n and its children.
+func flipColors[K, V any](n *ordered.Node[K, V]) {
+	n.Red = !n.Red
+	n.Left.Red = !n.Left.Red
+	n.Right.Red = !n.Right.Red
+}
+
+// balance - restores left-leaning red-black invariants of n,
+// which children are balanced, returns new subtree root.
+func balance[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	if isRed(n.Right) && !isRed(n.Left) {
+		n = rotateLeft(n)
+	}
+	if isRed(n.Left) && isRed(n.Left.Left) {
+		n = rotateRight(n)
+	}
+	if isRed(n.Left) && isRed(n.Right) {
+		flipColors(n)
+	}
+
+	n.Update()
+	return n
+}
+
+// moveRedLeft - makes left child of n or its child red,
+// assuming n is red and both its children are black.
+func moveRedLeft[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	flipColors(n)
+	if isRed(n.Right.Left) {
+		n.Right = rotateRight(n.Right)
+		n = rotateLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight - makes right child of n or its child red,
+// assuming n is red and both its children are black.
+func moveRedRight[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	flipColors(n)
+	if isRed(n.Left.Left) {
+		n = rotateRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// Insert - inserts entry into tree with root n, returns its new black root.
+func (llrb[K, V]) Insert(
+	n *ordered.Node[K, V], key K, value V, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	root, added := insert(n, key, value, compare)
+	root.Red = false
+	return root, added
+}
+
+// insert - inserts entry into n subtree, returns its root.
+func insert[K, V any](
+	n *ordered.Node[K, V], key K, value V, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	if n == nil {
+		return &ordered.Node[K, V]{Key: key, Value: value, Red: true, Height: 1}, true
+	}
+
+	var added bool
+	switch c := compare(key, n.Key); {
+	case c < 0:
+		n.Left, added = insert(n.Left, key, value, compare)
+	case c > 0:
+		n.Right, added = insert(n.Right, key, value, compare)
+	default:
+		n.Value = value
+		return n, false
+	}
+
+	return balance(n), added
+}
+
+// Delete - deletes entry from tree with root n, returns its new black root.
+// Node with two children is replaced by its successor.
+func (llrb[K, V]) Delete(
+	n *ordered.Node[K, V], key K, compare func(a, b K) int,
+) (*ordered.Node[K, V], bool) {
+	if ordered.Find(n, key, compare) == nil {
+		return n, false
+	}
+
+	if !isRed(n.Left) && !isRed(n.Right) {
+		n.Red = true
+	}
+
+	n = remove(n, key, compare)
+	if n != nil {
+		n.Red = false
+	}
+	return n, true
+}
+
+// remove - deletes existing entry from n subtree, returns its root.
+func remove[K, V any](n *ordered.Node[K, V], key K, compare func(a, b K) int) *ordered.Node[K, V] {
+	if compare(key, n.Key) < 0 {
+		if !isRed(n.Left) && !isRed(n.Left.Left) {
+			n = moveRedLeft(n)
+		}
+		n.Left = remove(n.Left, key, compare)
+		return balance(n)
+	}
+
+	if isRed(n.Left) {
+		n = rotateRight(n)
+	}
+	if compare(key, n.Key) == 0 && n.Right == nil {
+		return nil
+	}
+	if !isRed(n.Right) && !isRed(n.Right.Left) {
+		n = moveRedRight(n)
+	}
+
+	if compare(key, n.Key) == 0 {
+		successor := n.Right.Min()
+		n.Key, n.Value = successor.Key, successor.Value
+		n.Right = deleteMin(n.Right)
+	} else {
+		n.Right = remove(n.Right, key, compare)
+	}
+	return balance(n)
+}
+
+// deleteMin - deletes Node with the least key from n subtree,
+// returns its root.
+func deleteMin[K, V any](n *ordered.Node[K, V]) *ordered.Node[K, V] {
+	if n.Left == nil {
+		return nil
+	}
+
+	if !isRed(n.Left) && !isRed(n.Left.Left) {
+		n = moveRedLeft(n)
+	}
+	n.Left = deleteMin(n.Left)
+	return balance(n)
+}
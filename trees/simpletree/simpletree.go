@@ -1,6 +1,9 @@
 package simpletree
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // Tree represents a Tree
 // that holds values of any type.
@@ -82,3 +85,71 @@ func (t *Tree[T]) FindDepth() int {
 	t.Depth = find(t.Root)
 	return t.Depth
 }
+
+// PreOrder - returns iterator over Node's data in pre-order:
+// node, then its childrens from first to last.
+func (t *Tree[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Root.preOrder(yield)
+	}
+}
+
+// PostOrder - returns iterator over Node's data in post-order:
+// childrens from first to last, then node.
+func (t *Tree[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Root.postOrder(yield)
+	}
+}
+
+// LevelOrder - returns iterator over Node's data level by level.
+func (t *Tree[T]) LevelOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.Root == nil {
+			return
+		}
+
+		level := []*Node[T]{t.Root}
+		for len(level) > 0 {
+			var next []*Node[T]
+			for _, n := range level {
+				if !yield(n.Data) {
+					return
+				}
+				next = append(next, n.Childrens...)
+			}
+			level = next
+		}
+	}
+}
+
+// preOrder - returns false, if yield stopped the traversal.
+func (n *Node[T]) preOrder(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if !yield(n.Data) {
+		return false
+	}
+	for _, children := range n.Childrens {
+		if !children.preOrder(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// postOrder - returns false, if yield stopped the traversal.
+func (n *Node[T]) postOrder(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for _, children := range n.Childrens {
+		if !children.postOrder(yield) {
+			return false
+		}
+	}
+	return yield(n.Data)
+}
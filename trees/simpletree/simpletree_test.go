@@ -0,0 +1,48 @@
+package simpletree
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestTraversals(t *testing.T) {
+	t.Parallel()
+
+	tree := NewTree("a")
+	b := tree.Root.AddNode("b")
+	b.AddNode("d")
+	b.AddNode("e")
+	tree.Root.AddNode("c").AddNode("f")
+
+	exp := []string{"a", "b", "d", "e", "c", "f"}
+	if got := slices.Collect(tree.PreOrder()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected pre-order: %v\nGot: %v", exp, got)
+	}
+
+	exp = []string{"d", "e", "b", "f", "c", "a"}
+	if got := slices.Collect(tree.PostOrder()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected post-order: %v\nGot: %v", exp, got)
+	}
+
+	exp = []string{"a", "b", "c", "d", "e", "f"}
+	if got := slices.Collect(tree.LevelOrder()); !reflect.DeepEqual(exp, got) {
+		t.Fatalf("Expected level-order: %v\nGot: %v", exp, got)
+	}
+
+	for _, seq := range []func(func(string) bool){
+		tree.PreOrder(), tree.PostOrder(), tree.LevelOrder(),
+	} {
+		var got []string
+		for v := range seq {
+			got = append(got, v)
+			if len(got) == 4 {
+				break
+			}
+		}
+
+		if len(got) != 4 {
+			t.Fatalf("Expected visited: 4\nGot: %v", got)
+		}
+	}
+}